@@ -0,0 +1,95 @@
+package time
+
+import (
+	"context"
+	"time"
+)
+
+// LeapDayPolicy decides on which day the anniversary of February 29 falls in non-leap years
+type LeapDayPolicy int
+
+const (
+	// LeapDayFebruary28 moves the anniversary to February 28, as Polish civil code does for ages and terms
+	LeapDayFebruary28 LeapDayPolicy = iota
+	// LeapDayMarch1 moves the anniversary to March 1, as e.g. the UK does for ages
+	LeapDayMarch1
+)
+
+// AnniversaryIn returns the anniversary of date in given year
+func (p LeapDayPolicy) AnniversaryIn(date LocalDate, year int) LocalDate {
+	_, month, day := date.Date()
+	if month == time.February && day == 29 && daysIn(time.February, year) == 28 {
+		if p == LeapDayMarch1 {
+			return NewLocalDate(year, time.March, 1)
+		}
+		return NewLocalDate(year, time.February, 28)
+	}
+	return NewLocalDate(year, month, day)
+}
+
+// AgeAt returns the number of complete years from birth to on, i.e. the number of anniversaries
+// of birth which passed until on, including on. It is negative when on is before birth.
+func (p LeapDayPolicy) AgeAt(birth, on LocalDate) int {
+	if birth.After(on) {
+		return -p.AgeAt(on, birth)
+	}
+	age := on.Year() - birth.Year()
+	if p.AnniversaryIn(birth, on.Year()).After(on) {
+		age--
+	}
+	return age
+}
+
+// ExactAgeAt returns complete years, months and days from birth to on.
+// Months and days are counted from the last anniversary. It is negative when on is before birth.
+func (p LeapDayPolicy) ExactAgeAt(birth, on LocalDate) CalendarAmount {
+	if birth.After(on) {
+		return p.ExactAgeAt(on, birth).Negated()
+	}
+	years := p.AgeAt(birth, on)
+	sinceAnniversary := CalendarAmountBetween(p.AnniversaryIn(birth, birth.Year()+years), on)
+	return NewCalendarAmount(years, sinceAnniversary.Months(), sinceAnniversary.Days())
+}
+
+// NextAnniversary returns the first anniversary of date which is after given date.
+// The date itself is not its own anniversary.
+func (p LeapDayPolicy) NextAnniversary(date, after LocalDate) LocalDate {
+	year := after.Year()
+	if year <= date.Year() {
+		year = date.Year() + 1
+	}
+	if anniversary := p.AnniversaryIn(date, year); anniversary.After(after) {
+		return anniversary
+	}
+	return p.AnniversaryIn(date, year+1)
+}
+
+// AgeAt returns the number of complete years from birth to on using LeapDayFebruary28
+func AgeAt(birth, on LocalDate) int {
+	return LeapDayFebruary28.AgeAt(birth, on)
+}
+
+// ExactAgeAt returns complete years, months and days from birth to on using LeapDayFebruary28
+func ExactAgeAt(birth, on LocalDate) CalendarAmount {
+	return LeapDayFebruary28.ExactAgeAt(birth, on)
+}
+
+// NextAnniversary returns the first anniversary of date after given date using LeapDayFebruary28
+func NextAnniversary(date, after LocalDate) LocalDate {
+	return LeapDayFebruary28.NextAnniversary(date, after)
+}
+
+// Age returns the number of complete years from birth to today
+func Age(ctx context.Context, clock Clock, birth LocalDate) int {
+	return AgeAt(birth, clock.Today(ctx))
+}
+
+// ExactAge returns complete years, months and days from birth to today
+func ExactAge(ctx context.Context, clock Clock, birth LocalDate) CalendarAmount {
+	return ExactAgeAt(birth, clock.Today(ctx))
+}
+
+// UpcomingAnniversary returns the first anniversary of date after today
+func UpcomingAnniversary(ctx context.Context, clock Clock, date LocalDate) LocalDate {
+	return NextAnniversary(date, clock.Today(ctx))
+}
@@ -0,0 +1,69 @@
+package time
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgeAt(t *testing.T) {
+	birth := MustParseLocalDate("1990-05-15")
+	assert.Equal(t, 27, AgeAt(birth, MustParseLocalDate("2018-05-14")))
+	assert.Equal(t, 28, AgeAt(birth, MustParseLocalDate("2018-05-15")))
+	assert.Equal(t, 0, AgeAt(birth, birth))
+	assert.Equal(t, -1, AgeAt(birth, MustParseLocalDate("1989-05-15")))
+
+	leapling := MustParseLocalDate("2000-02-29")
+	tests := []struct {
+		on       string
+		february int
+		march    int
+	}{
+		{"2001-02-27", 0, 0},
+		{"2001-02-28", 1, 0},
+		{"2001-03-01", 1, 1},
+		{"2004-02-28", 3, 3},
+		{"2004-02-29", 4, 4},
+	}
+	for _, test := range tests {
+		on := MustParseLocalDate(test.on)
+		assert.Equal(t, test.february, LeapDayFebruary28.AgeAt(leapling, on), test.on)
+		assert.Equal(t, test.march, LeapDayMarch1.AgeAt(leapling, on), test.on)
+	}
+	assert.Equal(t, 1, AgeAt(leapling, MustParseLocalDate("2001-02-28")))
+}
+
+func TestExactAgeAt(t *testing.T) {
+	birth := MustParseLocalDate("1990-05-15")
+	assert.Equal(t, NewCalendarAmount(28, 1, 3), ExactAgeAt(birth, MustParseLocalDate("2018-06-18")))
+	assert.Equal(t, NewCalendarAmount(27, 11, 29), ExactAgeAt(birth, MustParseLocalDate("2018-05-14")))
+	assert.Equal(t, NewCalendarAmount(-1, 0, -1), ExactAgeAt(birth, MustParseLocalDate("1989-05-14")))
+
+	leapling := MustParseLocalDate("2000-02-29")
+	assert.Equal(t, NewCalendarAmount(1, 0, 0), LeapDayFebruary28.ExactAgeAt(leapling, MustParseLocalDate("2001-02-28")))
+	assert.Equal(t, NewCalendarAmount(0, 11, 30), LeapDayMarch1.ExactAgeAt(leapling, MustParseLocalDate("2001-02-28")))
+	assert.Equal(t, NewCalendarAmount(1, 0, 1), LeapDayFebruary28.ExactAgeAt(leapling, MustParseLocalDate("2001-03-01")))
+	assert.Equal(t, NewCalendarAmount(1, 0, 0), LeapDayMarch1.ExactAgeAt(leapling, MustParseLocalDate("2001-03-01")))
+}
+
+func TestNextAnniversary(t *testing.T) {
+	signed := MustParseLocalDate("2016-03-10")
+	assert.Equal(t, MustParseLocalDate("2017-03-10"), NextAnniversary(signed, signed))
+	assert.Equal(t, MustParseLocalDate("2017-03-10"), NextAnniversary(signed, MustParseLocalDate("2010-01-01")))
+	assert.Equal(t, MustParseLocalDate("2018-03-10"), NextAnniversary(signed, MustParseLocalDate("2018-03-09")))
+	assert.Equal(t, MustParseLocalDate("2019-03-10"), NextAnniversary(signed, MustParseLocalDate("2018-03-10")))
+
+	leapling := MustParseLocalDate("2016-02-29")
+	assert.Equal(t, MustParseLocalDate("2018-02-28"), LeapDayFebruary28.NextAnniversary(leapling, MustParseLocalDate("2018-01-01")))
+	assert.Equal(t, MustParseLocalDate("2018-03-01"), LeapDayMarch1.NextAnniversary(leapling, MustParseLocalDate("2018-01-01")))
+	assert.Equal(t, MustParseLocalDate("2019-02-28"), LeapDayFebruary28.NextAnniversary(leapling, MustParseLocalDate("2018-02-28")))
+	assert.Equal(t, MustParseLocalDate("2020-02-29"), LeapDayMarch1.NextAnniversary(leapling, MustParseLocalDate("2019-03-01")))
+}
+
+func TestAgeFromClock(t *testing.T) {
+	ctx := contextAt(t, "2018-05-14 23:30")
+	birth := MustParseLocalDate("1990-05-15")
+	assert.Equal(t, 27, Age(ctx, NewClock(), birth))
+	assert.Equal(t, NewCalendarAmount(27, 11, 29), ExactAge(ctx, NewClock(), birth))
+	assert.Equal(t, MustParseLocalDate("2018-05-15"), UpcomingAnniversary(ctx, NewClock(), birth))
+}
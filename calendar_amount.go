@@ -0,0 +1,277 @@
+package time
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// CalendarAmount is an amount of time in years, months and days, e.g. "1 year 2 months 3 days".
+// Unlike Duration its length depends on the date it is added to, and unlike Period
+// it is not anchored to any date. Its textual form is ISO 8601 duration, e.g. "P1Y2M3D".
+type CalendarAmount struct {
+	years  int
+	months int
+	days   int
+}
+
+// ZeroCalendarAmount is an amount of no years, months and days
+var ZeroCalendarAmount = CalendarAmount{}
+
+// NewCalendarAmount creates CalendarAmount. Values are kept as they are, use Normalized
+// to carry months over to years.
+func NewCalendarAmount(years, months, days int) CalendarAmount {
+	return CalendarAmount{years: years, months: months, days: days}
+}
+
+// CalendarAmountBetween returns amount which added to from gives to, e.g. P1M1D between
+// 2018-01-30 and 2018-03-01 (as 2018-01-30 plus one month is 2018-02-28).
+// Years, months and days have the same sign, negative when to is before from.
+func CalendarAmountBetween(from, to LocalDate) CalendarAmount {
+	fromYear, fromMonth, fromDay := from.Date()
+	toYear, toMonth, toDay := to.Date()
+	totalMonths := (toYear*12 + int(toMonth)) - (fromYear*12 + int(fromMonth))
+	// the last month counts only when day of month is reached, in either direction
+	if totalMonths > 0 && toDay < fromDay {
+		totalMonths--
+	} else if totalMonths < 0 && toDay > fromDay {
+		totalMonths++
+	}
+	// days are counted from the date months lead to, which may be clamped to the end of month
+	days := daysBetween(from.AddAmount(NewCalendarAmount(0, totalMonths, 0)), to)
+	return CalendarAmount{years: totalMonths / 12, months: totalMonths % 12, days: days}
+}
+
+// ParseCalendarAmount parses ISO 8601 duration in years, months, weeks and days, e.g. "P1Y2M3D", "P2W" or "-P1M".
+// Components may have their own sign, e.g. "P1Y-2M". Weeks are converted to days.
+func ParseCalendarAmount(value string) (CalendarAmount, error) {
+	wrongFormat := fmt.Errorf("Wrong CalendarAmount format: %v", value)
+	text := strings.ToUpper(value)
+	sign := 1
+	switch {
+	case strings.HasPrefix(text, "-"):
+		sign = -1
+		text = text[1:]
+	case strings.HasPrefix(text, "+"):
+		text = text[1:]
+	}
+	if !strings.HasPrefix(text, "P") || len(text) == 1 {
+		return ZeroCalendarAmount, wrongFormat
+	}
+	text = text[1:]
+
+	var amount CalendarAmount
+	order := "YMWD"
+	for text != "" {
+		end := 0
+		if end < len(text) && (text[end] == '-' || text[end] == '+') {
+			end++
+		}
+		for end < len(text) && text[end] >= '0' && text[end] <= '9' {
+			end++
+		}
+		if end == len(text) {
+			return ZeroCalendarAmount, wrongFormat
+		}
+		number, err := strconv.Atoi(text[:end])
+		if err != nil {
+			return ZeroCalendarAmount, wrongFormat
+		}
+		designator := strings.IndexByte(order, text[end])
+		if designator < 0 {
+			return ZeroCalendarAmount, wrongFormat
+		}
+		switch order[designator] {
+		case 'Y':
+			amount.years = sign * number
+		case 'M':
+			amount.months = sign * number
+		case 'W':
+			amount.days = sign * number * 7
+		case 'D':
+			amount.days += sign * number
+		}
+		// designators must come in order and only once
+		order = order[designator+1:]
+		text = text[end+1:]
+	}
+	return amount, nil
+}
+
+// MustParseCalendarAmount is like ParseCalendarAmount but panics on error
+func MustParseCalendarAmount(value string) CalendarAmount {
+	amount, err := ParseCalendarAmount(value)
+	if err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// Years returns the number of years
+func (a CalendarAmount) Years() int {
+	return a.years
+}
+
+// Months returns the number of months
+func (a CalendarAmount) Months() int {
+	return a.months
+}
+
+// Days returns the number of days
+func (a CalendarAmount) Days() int {
+	return a.days
+}
+
+// TotalMonths returns the number of years and months in months
+func (a CalendarAmount) TotalMonths() int {
+	return a.years*12 + a.months
+}
+
+// IsZero reports whether all components are zero
+func (a CalendarAmount) IsZero() bool {
+	return a == ZeroCalendarAmount
+}
+
+// IsNegative reports whether any of components is negative
+func (a CalendarAmount) IsNegative() bool {
+	return a.years < 0 || a.months < 0 || a.days < 0
+}
+
+// Normalized carries months over to years, so that months are between -11 and 11
+// and have the same sign as years, e.g. P1Y14M becomes P2Y2M and P1Y-2M becomes P10M.
+// Days are not changed, as the length of a month varies.
+func (a CalendarAmount) Normalized() CalendarAmount {
+	totalMonths := a.TotalMonths()
+	return CalendarAmount{years: totalMonths / 12, months: totalMonths % 12, days: a.days}
+}
+
+// Negated returns the amount with all components negated
+func (a CalendarAmount) Negated() CalendarAmount {
+	return CalendarAmount{years: -a.years, months: -a.months, days: -a.days}
+}
+
+// Plus returns the sum of amounts, component by component
+func (a CalendarAmount) Plus(other CalendarAmount) CalendarAmount {
+	return CalendarAmount{years: a.years + other.years, months: a.months + other.months, days: a.days + other.days}
+}
+
+// String formats the amount in ISO 8601, e.g. "P1Y2M3D" or "P-1M", zero amount is "P0D"
+func (a CalendarAmount) String() string {
+	if a.IsZero() {
+		return "P0D"
+	}
+	var sb strings.Builder
+	sb.WriteByte('P')
+	for _, component := range []struct {
+		value      int
+		designator byte
+	}{{a.years, 'Y'}, {a.months, 'M'}, {a.days, 'D'}} {
+		if component.value != 0 {
+			sb.WriteString(strconv.Itoa(component.value))
+			sb.WriteByte(component.designator)
+		}
+	}
+	return sb.String()
+}
+
+// AddAmount returns the date increased by amount. Years and months are added first
+// and if the day doesn't exist in resulting month, the last day of the month is used,
+// e.g. 2018-01-31 plus P1M is 2018-02-28. Then days are added.
+func (d LocalDate) AddAmount(amount CalendarAmount) LocalDate {
+	year, month, day := d.Date()
+	totalMonths := year*12 + int(month) - 1 + amount.TotalMonths()
+	year, month = int(floorDiv(int64(totalMonths), 12)), time.Month(floorMod(int64(totalMonths), 12)+1)
+	if length := daysIn(month, year); day > length {
+		day = length
+	}
+	return NewLocalDate(year, month, day+amount.days)
+}
+
+// AddAmount returns the date-time increased by amount as in LocalDate.AddAmount, keeping the time
+func (ldt LocalDateTime) AddAmount(amount CalendarAmount) LocalDateTime {
+	date := ldt.Date().AddAmount(amount)
+	year, month, day := date.Date()
+	hour, minute, second := ldt.t.Clock()
+	return NewLocalDateTime(time.Date(year, month, day, hour, minute, second, ldt.t.Nanosecond(), time.UTC))
+}
+
+// daysIn returns the number of days in month
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// daysBetween returns the number of days from date to other date
+func daysBetween(from, to LocalDate) int {
+	return int(to.GetStartOfDayUTC().Sub(from.GetStartOfDayUTC()) / (24 * time.Hour))
+}
+
+// MarshalText serializes amount to ISO 8601 string
+func (a CalendarAmount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText parses ISO 8601 string into amount
+func (a *CalendarAmount) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	amount, err := ParseCalendarAmount(string(text))
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+// MarshalJSON marshals amount to JSON string in ISO 8601
+func (a CalendarAmount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON parses JSON string in ISO 8601 into amount
+func (a *CalendarAmount) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	a.unmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (a *CalendarAmount) unmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		amount, err := ParseCalendarAmount(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*a = amount
+	}
+}
+
+// Scan implements the Scanner interface.
+// It accepts PostgreSQL interval in postgres style, e.g. "1 year 2 mons 3 days",
+// and in ISO 8601 style. Time part of the interval must be zero.
+func (a *CalendarAmount) Scan(value interface{}) error {
+	text, err := scanText("CalendarAmount", value)
+	if err != nil {
+		return err
+	}
+	interval, err := parsePgInterval(text)
+	if err == nil && interval.time != 0 {
+		err = fmt.Errorf("Interval has non-zero time: %v", text)
+	}
+	if err != nil {
+		return fmt.Errorf("sql: failed to scan into CalendarAmount: %v", err)
+	}
+	*a = interval.amount
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+// Amount is written in ISO 8601, which PostgreSQL accepts as interval.
+func (a CalendarAmount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
@@ -0,0 +1,146 @@
+package time
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCalendarAmount(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected CalendarAmount
+	}{
+		{"P1Y2M3D", NewCalendarAmount(1, 2, 3)},
+		{"P14M", NewCalendarAmount(0, 14, 0)},
+		{"P2W", NewCalendarAmount(0, 0, 14)},
+		{"P1W2D", NewCalendarAmount(0, 0, 9)},
+		{"p0d", ZeroCalendarAmount},
+		{"-P1Y2M", NewCalendarAmount(-1, -2, 0)},
+		{"P1Y-2M", NewCalendarAmount(1, -2, 0)},
+		{"-P-3D", NewCalendarAmount(0, 0, 3)},
+	}
+	for _, test := range tests {
+		actual, err := ParseCalendarAmount(test.value)
+		assert.NoError(t, err, test.value)
+		assert.Equal(t, test.expected, actual, test.value)
+	}
+
+	for _, value := range []string{"", "P", "1Y", "P1", "P1D1Y", "P1Y1Y", "P1H", "PT1H", "P1.5Y", "P--1D"} {
+		_, err := ParseCalendarAmount(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestCalendarAmountString(t *testing.T) {
+	assert.Equal(t, "P1Y2M3D", NewCalendarAmount(1, 2, 3).String())
+	assert.Equal(t, "P0D", ZeroCalendarAmount.String())
+	assert.Equal(t, "P-1M5D", NewCalendarAmount(0, -1, 5).String())
+	assert.Equal(t, "P-1Y-2M-3D", NewCalendarAmount(1, 2, 3).Negated().String())
+}
+
+func TestCalendarAmountNormalized(t *testing.T) {
+	assert.Equal(t, NewCalendarAmount(2, 2, 40), NewCalendarAmount(1, 14, 40).Normalized())
+	assert.Equal(t, NewCalendarAmount(0, 10, 0), NewCalendarAmount(1, -2, 0).Normalized())
+	assert.Equal(t, NewCalendarAmount(-1, -1, 0), NewCalendarAmount(0, -13, 0).Normalized())
+	assert.Equal(t, 14, NewCalendarAmount(1, 2, 0).TotalMonths())
+	assert.Equal(t, NewCalendarAmount(1, 3, 5), NewCalendarAmount(1, 2, 3).Plus(NewCalendarAmount(0, 1, 2)))
+	assert.True(t, NewCalendarAmount(1, -2, 0).IsNegative())
+	assert.False(t, NewCalendarAmount(1, 2, 0).IsNegative())
+}
+
+func TestCalendarAmountBetween(t *testing.T) {
+	tests := []struct {
+		from, to string
+		expected CalendarAmount
+	}{
+		{"2018-01-01", "2018-01-01", ZeroCalendarAmount},
+		{"2018-01-15", "2019-03-18", NewCalendarAmount(1, 2, 3)},
+		{"2018-01-30", "2018-03-01", NewCalendarAmount(0, 1, 1)},
+		{"2018-03-01", "2018-01-30", NewCalendarAmount(0, -1, -2)},
+		{"2016-02-29", "2017-02-28", NewCalendarAmount(0, 11, 30)},
+		{"2018-05-31", "2018-06-30", NewCalendarAmount(0, 0, 30)},
+		{"2018-03-31", "2018-02-28", NewCalendarAmount(0, -1, 0)},
+		{"2018-03-31", "2018-02-27", NewCalendarAmount(0, -1, -1)},
+		{"2018-03-31", "2017-02-28", NewCalendarAmount(-1, -1, 0)},
+	}
+	for _, test := range tests {
+		from, to := MustParseLocalDate(test.from), MustParseLocalDate(test.to)
+		amount := CalendarAmountBetween(from, to)
+		assert.Equal(t, test.expected, amount, "%v - %v", test.from, test.to)
+		assert.Equal(t, to, from.AddAmount(amount), "%v + %v", test.from, amount)
+	}
+}
+
+func TestCalendarAmountBetweenRoundTrip(t *testing.T) {
+	var monthEnds []LocalDate
+	for month := time.January; month <= time.December; month++ {
+		for _, year := range []int{2016, 2017} {
+			end := NewLocalDate(year, month, daysIn(month, year))
+			monthEnds = append(monthEnds, end.AddDate(0, 0, -1), end, end.Next())
+		}
+	}
+	for _, from := range monthEnds {
+		for _, to := range monthEnds {
+			amount := CalendarAmountBetween(from, to)
+			assert.Equal(t, to, from.AddAmount(amount), "%v + %v", from, amount)
+		}
+	}
+}
+
+func TestAddCalendarAmount(t *testing.T) {
+	assert.Equal(t, MustParseLocalDate("2018-02-28"), MustParseLocalDate("2018-01-31").AddAmount(MustParseCalendarAmount("P1M")))
+	assert.Equal(t, MustParseLocalDate("2017-02-28"), MustParseLocalDate("2016-02-29").AddAmount(MustParseCalendarAmount("P1Y")))
+	assert.Equal(t, MustParseLocalDate("2018-03-03"), MustParseLocalDate("2018-01-31").AddAmount(MustParseCalendarAmount("P1M3D")))
+	assert.Equal(t, MustParseLocalDate("2017-11-30"), MustParseLocalDate("2018-01-31").AddAmount(MustParseCalendarAmount("-P2M")))
+	assert.Equal(t,
+		NewLocalDateTime(time.Date(2019, 3, 1, 15, 4, 5, 6, time.UTC)),
+		NewLocalDateTime(time.Date(2018, 1, 31, 15, 4, 5, 6, time.UTC)).AddAmount(NewCalendarAmount(1, 1, 1)))
+}
+
+func TestCalendarAmountJSON(t *testing.T) {
+	type contract struct {
+		Notice CalendarAmount `json:"notice"`
+	}
+	JSON, err := json.Marshal(contract{Notice: NewCalendarAmount(0, 3, 0)})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"notice":"P3M"}`, string(JSON))
+
+	var actual contract
+	assert.NoError(t, json.Unmarshal([]byte(`{"notice":"P1Y2W"}`), &actual))
+	assert.Equal(t, NewCalendarAmount(1, 0, 14), actual.Notice)
+	assert.Error(t, json.Unmarshal([]byte(`{"notice":"3 months"}`), &actual))
+}
+
+func TestCalendarAmountScanAndValue(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected CalendarAmount
+	}{
+		{"1 year 2 mons 3 days", NewCalendarAmount(1, 2, 3)},
+		{[]byte("2 years 00:00:00"), NewCalendarAmount(2, 0, 0)},
+		{"-1 mons +3 days", NewCalendarAmount(0, -1, 3)},
+		{"14 mon 3 day 00:00:00", NewCalendarAmount(0, 14, 3)},
+		{"00:00:00", ZeroCalendarAmount},
+		{"P1Y2M3D", NewCalendarAmount(1, 2, 3)},
+		{"P1YT0S", NewCalendarAmount(1, 0, 0)},
+		{"PT0S", ZeroCalendarAmount},
+	}
+	for _, test := range tests {
+		var amount CalendarAmount
+		assert.NoError(t, amount.Scan(test.value), "%v", test.value)
+		assert.Equal(t, test.expected, amount, "%v", test.value)
+	}
+
+	var amount CalendarAmount
+	assert.Error(t, amount.Scan("1 day 01:00:00"))
+	assert.Error(t, amount.Scan("P1DT1H"))
+	assert.Error(t, amount.Scan("3 weeks"))
+	assert.Error(t, amount.Scan(nil))
+
+	value, err := NewCalendarAmount(1, -2, 3).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "P1Y-2M3D", value)
+}
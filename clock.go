@@ -3,6 +3,7 @@ package time
 import (
 	"context"
 	"database/sql/driver"
+	"strconv"
 	"time"
 )
 
@@ -16,15 +17,87 @@ func (uts UnixTimeStamp) Value() (driver.Value, error) {
 }
 
 // Scan implements the Scanner interface.
+// It accepts integer values as well as their textual form.
 func (uts *UnixTimeStamp) Scan(value interface{}) error {
-	*uts = UnixTimeStamp(value.(int64))
-	return nil
+	return scanUnixTimestamp("UnixTimeStamp", value, time.Second, (*int64)(uts))
+}
+
+// MarshalJSON marshals timestamp to JSON number
+func (uts UnixTimeStamp) MarshalJSON() ([]byte, error) {
+	return unixTimestampText(int64(uts)), nil
+}
+
+// UnmarshalJSON parses JSON number, or a string containing a number, into timestamp
+func (uts *UnixTimeStamp) UnmarshalJSON(data []byte) error {
+	return unmarshalUnixTimestampJSON(data, (*int64)(uts))
+}
+
+// MarshalText serializes timestamp to decimal string
+func (uts UnixTimeStamp) MarshalText() ([]byte, error) {
+	return unixTimestampText(int64(uts)), nil
+}
+
+// UnmarshalText parses decimal string into timestamp
+func (uts *UnixTimeStamp) UnmarshalText(text []byte) error {
+	return parseUnixTimestamp(text, (*int64)(uts))
+}
+
+// Instant converts timestamp to Instant
+func (uts UnixTimeStamp) Instant() Instant {
+	return NewInstant(int64(uts), 0)
 }
 
 func (uts UnixTimeStamp) SecondsTo(t time.Time) int64 {
 	return int64(uts) - t.Unix()
 }
 
+// scanUnixTimestamp scans column value into timestamp counting given units since January 1, 1970 UTC
+func scanUnixTimestamp(typeName string, value interface{}, unit time.Duration, uts *int64) error {
+	n, err := scanUnix(typeName, value, unit)
+	if err != nil {
+		return err
+	}
+	*uts = n
+	return nil
+}
+
+// unixTimestampText serializes timestamp to decimal number, the same for JSON and text
+func unixTimestampText(uts int64) []byte {
+	return strconv.AppendInt(nil, uts, 10)
+}
+
+// unmarshalUnixTimestampJSON parses JSON number, or a string containing a number, into timestamp
+func unmarshalUnixTimestampJSON(data []byte, uts *int64) error {
+	if unquoted, err := strconv.Unquote(string(data)); err == nil {
+		data = []byte(unquoted)
+	}
+	return parseUnixTimestamp(data, uts)
+}
+
+// parseUnixTimestamp parses decimal string into timestamp
+func parseUnixTimestamp(text []byte, uts *int64) error {
+	n, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	*uts = n
+	return nil
+}
+
+// unixTimestamp returns number of units elapsed since January 1, 1970 UTC until t
+func unixTimestamp(t time.Time, unit time.Duration) int64 {
+	switch unit {
+	case time.Millisecond:
+		return t.UnixMilli()
+	case time.Microsecond:
+		return t.UnixMicro()
+	case time.Nanosecond:
+		return t.UnixNano()
+	default:
+		return t.Unix()
+	}
+}
+
 // UserTypeCtxKey is type to keep time in context
 type TimeCtxKey string
 
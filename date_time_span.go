@@ -1,11 +1,15 @@
 package time
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
-// DateTimeSpan represents time span between two moments
+// DateTimeSpan represents time span between two moments, from inclusive to exclusive.
+// Span may be open-ended, when to is NullLocalDateTime, or open-started, when from is NullLocalDateTime,
+// and then it extends indefinitely in that direction.
 type DateTimeSpan struct {
 	from LocalDateTime
 	to   LocalDateTime
@@ -42,16 +46,56 @@ func MustNewOpenDateTimeSpanFrom(from LocalDateTime) DateTimeSpan {
 	return span
 }
 
-// From returns start of DateTimeSpan
+// NewOpenDateTimeSpanUntil creates DateTimeSpan that represents all moments before "to" (exclusive)
+func NewOpenDateTimeSpanUntil(to LocalDateTime) (DateTimeSpan, error) {
+	if to.IsNull() {
+		return DateTimeSpan{from: NullLocalDateTime, to: NullLocalDateTime}, ErrPeriodInvalidParamNull
+	}
+	return DateTimeSpan{from: NullLocalDateTime, to: to}, nil
+}
+
+// MustNewOpenDateTimeSpanUntil is like NewOpenDateTimeSpanUntil but panics on error
+func MustNewOpenDateTimeSpanUntil(to LocalDateTime) DateTimeSpan {
+	span, err := NewOpenDateTimeSpanUntil(to)
+	if err != nil {
+		panic(err)
+	}
+	return span
+}
+
+// From returns start of DateTimeSpan, NullLocalDateTime for an open-started span
 func (ts DateTimeSpan) From() LocalDateTime {
 	return ts.from
 }
 
-// To returns end of DateTimeSpan
+// To returns end of DateTimeSpan, NullLocalDateTime for an open-ended span
 func (ts DateTimeSpan) To() LocalDateTime {
 	return ts.to
 }
 
+// IsOpen tells if span is open-started or open-ended
+func (ts DateTimeSpan) IsOpen() bool {
+	return ts.from.IsNull() || ts.to.IsNull()
+}
+
+// IsOpenStarted tells if span has no start
+func (ts DateTimeSpan) IsOpenStarted() bool {
+	return ts.from.IsNull()
+}
+
+// IsOpenEnded tells if span has no end
+func (ts DateTimeSpan) IsOpenEnded() bool {
+	return ts.to.IsNull()
+}
+
+// Duration returns time between start and end of span, false for an open span which lasts indefinitely
+func (ts DateTimeSpan) Duration() (Duration, bool) {
+	if ts.IsOpen() {
+		return 0, false
+	}
+	return ts.to.Sub(ts.from), true
+}
+
 // TimeSpan creates LocalTimeSpan based on this object start and stop time of a day.
 // Start and stop must be on the same day and start can't be after end.
 func (ts DateTimeSpan) TimeSpan() LocalTimeSpan {
@@ -63,14 +107,24 @@ func (ts DateTimeSpan) TimeSpan() LocalTimeSpan {
 	return NewTimeSpan(ts.from.Time(), ts.to.Time())
 }
 
-// Empty checks if DateTimeStamp has zero duration
+// Empty checks if DateTimeStamp has zero duration, open span is never empty
 func (ts DateTimeSpan) Empty() bool {
-	return ts.from == ts.to
+	return !ts.IsOpen() && ts.from == ts.to
 }
 
 // Overlaps checks if DateTimeSpan overlaps other DateTimeSpan
 func (ts DateTimeSpan) Overlaps(other DateTimeSpan) bool {
-	return ts.from.Before(other.to) && ts.to.After(other.from)
+	return ts.startsBefore(other.to) && other.startsBefore(ts.to)
+}
+
+// startsBefore tells if span starts before given end, which is NullLocalDateTime for an open-ended span
+func (ts DateTimeSpan) startsBefore(end LocalDateTime) bool {
+	return ts.from.IsNull() || end.IsNull() || ts.from.Before(end)
+}
+
+// NullableFrom returns start of a span or null
+func (ts DateTimeSpan) NullableFrom() NullableLocalDateTime {
+	return NewNullableLocalDateTime(ts.from)
 }
 
 // NullableTo returns last day of a period or null
@@ -84,15 +138,191 @@ func (ts DateTimeSpan) NullableTo() NullableLocalDateTime {
 
 // Contains checks if DateTimeSpan fully covers other DateTimeSpan
 func (ts DateTimeSpan) Contains(other DateTimeSpan) bool {
-	return !other.from.Before(ts.from) && !other.to.After(ts.to)
+	startsFirst := ts.from.IsNull() || !other.from.IsNull() && !other.from.Before(ts.from)
+	endsLast := ts.to.IsNull() || !other.to.IsNull() && !other.to.After(ts.to)
+	return startsFirst && endsLast
+}
+
+// Until returns span ending at horizon, or earlier when span ends before it,
+// e.g. to bound an open-ended span before splitting it
+func (ts DateTimeSpan) Until(horizon LocalDateTime) (DateTimeSpan, error) {
+	if !ts.to.IsNull() && !ts.to.After(horizon) {
+		return ts, nil
+	}
+	if ts.from.After(horizon) {
+		return DateTimeSpan{from: NullLocalDateTime, to: NullLocalDateTime}, ErrPeriodInvalidFromAfterTo
+	}
+	return DateTimeSpan{from: ts.from, to: horizon}, nil
+}
+
+// Clip returns part of span within bounding span, false when they don't overlap.
+// Open span clipped by a finite one becomes finite.
+func (ts DateTimeSpan) Clip(bounding DateTimeSpan) (DateTimeSpan, bool) {
+	if !ts.Overlaps(bounding) {
+		return DateTimeSpan{from: NullLocalDateTime, to: NullLocalDateTime}, false
+	}
+	clipped := ts
+	if bounding.from.After(ts.from) {
+		clipped.from = bounding.from
+	}
+	if ts.to.IsNull() || !bounding.to.IsNull() && bounding.to.Before(ts.to) {
+		clipped.to = bounding.to
+	}
+	return clipped, true
+}
+
+// ParseDateTimeSpan parses string in form of "2006-01-02 15:04/2006-01-02 15:04",
+// "2006-01-02 15:04/.." or "../2006-01-02 15:04" into DateTimeSpan
+func ParseDateTimeSpan(value string) (DateTimeSpan, error) {
+	parts := strings.Split(value, intervalSeparator)
+	if len(parts) != 2 || parts[0] == openIntervalEnd && parts[1] == openIntervalEnd {
+		return DateTimeSpan{from: NullLocalDateTime, to: NullLocalDateTime}, fmt.Errorf("Wrong DateTimeSpan format: %v", value)
+	}
+	var bounds [2]LocalDateTime
+	for i, part := range parts {
+		if part == openIntervalEnd {
+			continue
+		}
+		bound, err := ParseLocalDateTime(part)
+		if err != nil {
+			return DateTimeSpan{from: NullLocalDateTime, to: NullLocalDateTime}, err
+		}
+		bounds[i] = bound
+	}
+	return newValidOpenDateTimeSpan(bounds[0], bounds[1])
 }
 
+// MustParseDateTimeSpan is like ParseDateTimeSpan but panics on error
+func MustParseDateTimeSpan(value string) DateTimeSpan {
+	span, err := ParseDateTimeSpan(value)
+	if err != nil {
+		panic(err)
+	}
+	return span
+}
+
+// newValidOpenDateTimeSpan creates span which may be open-started or open-ended, but not both
+func newValidOpenDateTimeSpan(from LocalDateTime, to LocalDateTime) (DateTimeSpan, error) {
+	switch {
+	case from.IsNull():
+		return NewOpenDateTimeSpanUntil(to)
+	case to.IsNull():
+		return NewOpenDateTimeSpanFrom(from)
+	}
+	return newValidDateTimeSpan(from, to)
+}
+
+// newValidDateTimeSpan is like NewDateTimeSpan but returns an error instead of panicking
+func newValidDateTimeSpan(from LocalDateTime, to LocalDateTime) (DateTimeSpan, error) {
+	if from.IsNull() || to.IsNull() {
+		return DateTimeSpan{from: NullLocalDateTime, to: NullLocalDateTime}, ErrPeriodInvalidParamNull
+	}
+	if from.After(to) {
+		return DateTimeSpan{from: NullLocalDateTime, to: NullLocalDateTime}, ErrPeriodInvalidFromAfterTo
+	}
+	return DateTimeSpan{from: from, to: to}, nil
+}
+
+// MarshalText serializes span to string in form of "2006-01-02 15:04/2006-01-02 15:04",
+// with ".." in place of the missing bound of an open span, e.g. "2006-01-02 15:04/.."
+func (ts DateTimeSpan) MarshalText() ([]byte, error) {
+	from, to := openIntervalEnd, openIntervalEnd
+	if !ts.from.IsNull() {
+		from = ts.from.String()
+	}
+	if !ts.to.IsNull() {
+		to = ts.to.String()
+	}
+	return []byte(from + intervalSeparator + to), nil
+}
+
+// UnmarshalText parses string into span using ParseDateTimeSpan
+func (ts *DateTimeSpan) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	span, err := ParseDateTimeSpan(string(text))
+	if err != nil {
+		return err
+	}
+	*ts = span
+	return nil
+}
+
+type jsonDateTimeSpan struct {
+	From *LocalDateTime `json:"from"`
+	To   *LocalDateTime `json:"to"`
+}
+
+// MarshalJSON marshals span to JSON object, missing bound of an open span is null
 func (ts DateTimeSpan) MarshalJSON() ([]byte, error) {
-	type JSONLocalDateTime struct {
-		From LocalDateTime `json:"from"`
-		To   LocalDateTime `json:"to"`
+	var js jsonDateTimeSpan
+	if !ts.from.IsNull() {
+		js.From = &ts.from
+	}
+	if !ts.to.IsNull() {
+		js.To = &ts.to
+	}
+	return json.Marshal(js)
+}
+
+// UnmarshalJSON parses JSON object into span, null "from" or "to" means an open span
+func (ts *DateTimeSpan) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var js jsonDateTimeSpan
+	if err := json.Unmarshal(data, &js); err != nil {
+		return err
+	}
+	var from, to LocalDateTime
+	if js.From != nil {
+		from = *js.From
 	}
-	return json.Marshal(JSONLocalDateTime{From: ts.from, To: ts.to})
+	if js.To != nil {
+		to = *js.To
+	}
+	span, err := newValidOpenDateTimeSpan(from, to)
+	if err != nil {
+		return err
+	}
+	*ts = span
+	return nil
+}
+
+// Scan implements the Scanner interface.
+// It accepts PostgreSQL tsrange literals, e.g. `["2018-01-01 10:00:00","2018-01-01 11:30:00")`,
+// as well as text form of DateTimeSpan, e.g. "2018-01-01 10:00/2018-01-01 11:30".
+func (ts *DateTimeSpan) Scan(value interface{}) error {
+	text, err := scanText("DateTimeSpan", value)
+	if err != nil {
+		return err
+	}
+	var span DateTimeSpan
+	if isPgRange(text) {
+		r, err := parsePgRange(text)
+		if err != nil {
+			return err
+		}
+		span, err = dateTimeSpanFromPgRange(r)
+		if err != nil {
+			return err
+		}
+	} else {
+		span, err = ParseDateTimeSpan(text)
+		if err != nil {
+			return err
+		}
+	}
+	*ts = span
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+// DateTimeSpan is stored as PostgreSQL tsrange literal,
+// open span has unbounded upper bound.
+func (ts DateTimeSpan) Value() (driver.Value, error) {
+	return ts.pgRange().String(), nil
 }
 
 func (ts DateTimeSpan) Subtract(span DateTimeSpan) []DateTimeSpan {
@@ -102,12 +332,12 @@ func (ts DateTimeSpan) Subtract(span DateTimeSpan) []DateTimeSpan {
 	}
 
 	result := []DateTimeSpan{}
-	if ts.From().Before(span.From()) {
-		beforeSlot := NewDateTimeSpan(ts.From(), span.From())
+	if !span.from.IsNull() && ts.From().Before(span.From()) {
+		beforeSlot := DateTimeSpan{from: ts.from, to: span.from}
 		result = append(result, beforeSlot)
 	}
-	if ts.To().After(span.To()) {
-		afterSlot := NewDateTimeSpan(span.To(), ts.To())
+	if !span.to.IsNull() && (ts.to.IsNull() || ts.To().After(span.To())) {
+		afterSlot := DateTimeSpan{from: span.to, to: ts.to}
 		result = append(result, afterSlot)
 	}
 	return result
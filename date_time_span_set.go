@@ -0,0 +1,227 @@
+package time
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// DateTimeSpanSet is a set of moments kept as sorted spans, which neither overlap nor touch,
+// e.g. adding 10:00-11:00 and 11:00-12:00 gives 10:00-12:00. Empty spans are dropped.
+// DateTimeSpanSet is immutable, operations return new sets. Zero value is an empty set.
+type DateTimeSpanSet struct {
+	spans []DateTimeSpan
+}
+
+// NewDateTimeSpanSet creates DateTimeSpanSet of given spans, which may overlap and come in any order
+func NewDateTimeSpanSet(spans ...DateTimeSpan) DateTimeSpanSet {
+	return DateTimeSpanSet{}.Add(spans...)
+}
+
+// Spans returns spans of the set in order
+func (s DateTimeSpanSet) Spans() []DateTimeSpan {
+	return append(make([]DateTimeSpan, 0, len(s.spans)), s.spans...)
+}
+
+// Each calls fn for spans of the set in order, until fn returns false
+func (s DateTimeSpanSet) Each(fn func(DateTimeSpan) bool) {
+	for _, span := range s.spans {
+		if !fn(span) {
+			return
+		}
+	}
+}
+
+// Len returns the number of spans in the set
+func (s DateTimeSpanSet) Len() int {
+	return len(s.spans)
+}
+
+// IsEmpty tells if set contains no moments
+func (s DateTimeSpanSet) IsEmpty() bool {
+	return len(s.spans) == 0
+}
+
+// IsOpen tells if set contains an open-started or open-ended span
+func (s DateTimeSpanSet) IsOpen() bool {
+	return len(s.spans) > 0 && (s.spans[0].IsOpenStarted() || s.spans[len(s.spans)-1].IsOpenEnded())
+}
+
+// TotalDuration returns the sum of durations of spans, false when the set is open
+func (s DateTimeSpanSet) TotalDuration() (Duration, bool) {
+	if s.IsOpen() {
+		return 0, false
+	}
+	var total Duration
+	for _, span := range s.spans {
+		total += span.to.Sub(span.from)
+	}
+	return total, true
+}
+
+// Contains tells if any span of the set contains given moment
+func (s DateTimeSpanSet) Contains(ldt LocalDateTime) bool {
+	i := s.search(ldt)
+	return i < len(s.spans) && !s.spans[i].from.After(ldt)
+}
+
+// Overlaps tells if set has any moment in common with span
+func (s DateTimeSpanSet) Overlaps(span DateTimeSpan) bool {
+	i := s.search(span.from)
+	return i < len(s.spans) && (span.to.IsNull() || s.spans[i].from.Before(span.to))
+}
+
+// search returns index of the first span which ends after ldt
+func (s DateTimeSpanSet) search(ldt LocalDateTime) int {
+	return sort.Search(len(s.spans), func(i int) bool {
+		return !spanEndsBy(s.spans[i], ldt)
+	})
+}
+
+// Add returns set with given spans added
+func (s DateTimeSpanSet) Add(spans ...DateTimeSpan) DateTimeSpanSet {
+	if len(spans) == 0 {
+		return s
+	}
+	all := make([]DateTimeSpan, 0, len(s.spans)+len(spans))
+	all = append(append(all, s.spans...), spans...)
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].from.Before(all[j].from)
+	})
+	var result []DateTimeSpan
+	for _, span := range all {
+		if span.Empty() {
+			continue
+		}
+		last := len(result) - 1
+		if last < 0 || spanEndsBy(result[last], span.from) && result[last].to != span.from {
+			result = append(result, span)
+			continue
+		}
+		if span.to.IsNull() || !result[last].to.IsNull() && span.to.After(result[last].to) {
+			result[last].to = span.to
+		}
+	}
+	return DateTimeSpanSet{spans: result}
+}
+
+// Remove returns set without moments of given spans
+func (s DateTimeSpanSet) Remove(spans ...DateTimeSpan) DateTimeSpanSet {
+	return s.Difference(NewDateTimeSpanSet(spans...))
+}
+
+// Union returns set of moments which are in any of sets
+func (s DateTimeSpanSet) Union(other DateTimeSpanSet) DateTimeSpanSet {
+	return s.Add(other.spans...)
+}
+
+// Intersect returns set of moments which are in both sets
+func (s DateTimeSpanSet) Intersect(other DateTimeSpanSet) DateTimeSpanSet {
+	var result []DateTimeSpan
+	for i, j := 0, 0; i < len(s.spans) && j < len(other.spans); {
+		first, second := s.spans[i], other.spans[j]
+		from := first.from
+		if second.from.After(from) {
+			from = second.from
+		}
+		to := first.to
+		firstEndsLater := first.to.IsNull() || !second.to.IsNull() && first.to.After(second.to)
+		if firstEndsLater {
+			to = second.to
+		}
+		if to.IsNull() || from.Before(to) {
+			result = append(result, DateTimeSpan{from: from, to: to})
+		}
+		// the span which ends first can't overlap any further span of the other set
+		if firstEndsLater {
+			j++
+		} else {
+			i++
+		}
+	}
+	return DateTimeSpanSet{spans: result}
+}
+
+// Difference returns set of moments which are in this set but not in the other set
+func (s DateTimeSpanSet) Difference(other DateTimeSpanSet) DateTimeSpanSet {
+	var result []DateTimeSpan
+	j := 0
+	for _, span := range s.spans {
+		from, remains := span.from, true
+		for remains && j < len(other.spans) {
+			removed := other.spans[j]
+			if !span.to.IsNull() && !removed.from.Before(span.to) {
+				break
+			}
+			if spanEndsBy(removed, from) {
+				j++
+				continue
+			}
+			if removed.from.After(from) {
+				result = append(result, DateTimeSpan{from: from, to: removed.from})
+			}
+			if removed.to.IsNull() || !span.to.IsNull() && !removed.to.Before(span.to) {
+				remains = false
+				break
+			}
+			// removed span ends within this one, so it can't overlap any further span
+			from = removed.to
+			j++
+		}
+		if remains {
+			result = append(result, DateTimeSpan{from: from, to: span.to})
+		}
+	}
+	return DateTimeSpanSet{spans: result}
+}
+
+// Clip returns set of moments of this set which are within bounding span
+func (s DateTimeSpanSet) Clip(bounding DateTimeSpan) DateTimeSpanSet {
+	return s.Intersect(NewDateTimeSpanSet(bounding))
+}
+
+// Complement returns set of moments within given span which are not in this set
+func (s DateTimeSpanSet) Complement(within DateTimeSpan) DateTimeSpanSet {
+	return NewDateTimeSpanSet(within).Difference(s)
+}
+
+// FreeSlots returns spans within given span which are not in this set and last at least minDuration,
+// e.g. free slots of a calendar with busy spans in the set
+func (s DateTimeSpanSet) FreeSlots(within DateTimeSpan, minDuration Duration) []DateTimeSpan {
+	var slots []DateTimeSpan
+	for _, span := range s.Complement(within).spans {
+		if duration, bounded := span.Duration(); !bounded || duration >= minDuration {
+			slots = append(slots, span)
+		}
+	}
+	return slots
+}
+
+func (s DateTimeSpanSet) String() string {
+	parts := make([]string, len(s.spans))
+	for i, span := range s.spans {
+		text, _ := span.MarshalText()
+		parts[i] = string(text)
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// MarshalJSON marshals set to JSON array of spans
+func (s DateTimeSpanSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Spans())
+}
+
+// UnmarshalJSON parses JSON array of spans into set, spans are normalized as in NewDateTimeSpanSet
+func (s *DateTimeSpanSet) UnmarshalJSON(data []byte) error {
+	var spans []DateTimeSpan
+	if err := json.Unmarshal(data, &spans); err != nil {
+		return err
+	}
+	*s = NewDateTimeSpanSet(spans...)
+	return nil
+}
+
+// spanEndsBy tells if span ends before or at given moment, open span never does
+func spanEndsBy(span DateTimeSpan, ldt LocalDateTime) bool {
+	return !span.to.IsNull() && !span.to.After(ldt)
+}
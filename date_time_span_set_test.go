@@ -0,0 +1,114 @@
+package time
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func parseSpans(spans ...string) []DateTimeSpan {
+	var parsed []DateTimeSpan
+	for _, span := range spans {
+		parsed = append(parsed, MustParseDateTimeSpan(span))
+	}
+	return parsed
+}
+
+func spanSet(spans ...string) DateTimeSpanSet {
+	return NewDateTimeSpanSet(parseSpans(spans...)...)
+}
+
+func TestNewDateTimeSpanSetNormalizes(t *testing.T) {
+	set := spanSet("2018-01-01 12:00/2018-01-01 13:00", "2018-01-01 10:00/2018-01-01 11:00",
+		"2018-01-01 11:00/2018-01-01 11:30", "2018-01-01 12:30/2018-01-01 12:45", "2018-01-01 15:00/2018-01-01 15:00",
+		"2018-01-02 10:00/..", "2018-01-03 10:00/2018-01-03 11:00")
+	assert.Equal(t, []DateTimeSpan{
+		MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 11:30"),
+		MustParseDateTimeSpan("2018-01-01 12:00/2018-01-01 13:00"),
+		MustParseDateTimeSpan("2018-01-02 10:00/.."),
+	}, set.Spans())
+	assert.True(t, set.IsOpen())
+	assert.Equal(t, "{2018-01-01 10:00/2018-01-01 11:30, 2018-01-01 12:00/2018-01-01 13:00, 2018-01-02 10:00/..}", set.String())
+	assert.True(t, DateTimeSpanSet{}.IsEmpty())
+
+	set = spanSet("2018-01-01 10:00/2018-01-01 11:00", "../2018-01-01 09:00", "../2017-12-31 10:00")
+	assert.Equal(t, []DateTimeSpan{
+		MustParseDateTimeSpan("../2018-01-01 09:00"),
+		MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 11:00"),
+	}, set.Spans())
+	assert.True(t, set.IsOpen())
+	assert.True(t, set.Contains(MustParseLocalDateTime("1990-01-01 00:00")))
+	assert.Equal(t, spanSet("2018-01-01 09:00/2018-01-01 10:00", "2018-01-01 11:00/.."), set.Complement(MustParseDateTimeSpan("2018-01-01 08:00/..")))
+}
+
+func TestDateTimeSpanSetLookups(t *testing.T) {
+	set := spanSet("2018-01-01 10:00/2018-01-01 11:30", "2018-01-01 12:00/2018-01-01 13:00", "2018-01-02 10:00/..")
+	for ldt, expected := range map[string]bool{
+		"2018-01-01 09:59": false,
+		"2018-01-01 10:00": true,
+		"2018-01-01 11:29": true,
+		"2018-01-01 11:30": false,
+		"2018-01-01 12:30": true,
+		"2018-01-02 09:00": false,
+		"2030-01-01 00:00": true,
+	} {
+		assert.Equal(t, expected, set.Contains(MustParseLocalDateTime(ldt)), ldt)
+	}
+	assert.True(t, set.Overlaps(MustParseDateTimeSpan("2018-01-01 11:00/2018-01-01 11:45")))
+	assert.False(t, set.Overlaps(MustParseDateTimeSpan("2018-01-01 11:30/2018-01-01 12:00")))
+	assert.True(t, set.Overlaps(MustParseDateTimeSpan("2018-01-01 14:00/..")))
+	assert.False(t, set.Overlaps(MustParseDateTimeSpan("2018-01-01 14:00/2018-01-02 10:00")))
+}
+
+func TestDateTimeSpanSetTotalDuration(t *testing.T) {
+	total, ok := spanSet("2018-01-01 10:00/2018-01-01 11:30", "2018-01-01 12:00/2018-01-01 13:00").TotalDuration()
+	assert.True(t, ok)
+	assert.Equal(t, 2*Hour+30*Minute, total)
+	_, ok = spanSet("2018-01-01 10:00/..").TotalDuration()
+	assert.False(t, ok)
+}
+
+func TestDateTimeSpanSetOperations(t *testing.T) {
+	set := spanSet("2018-01-01 10:00/2018-01-01 12:00", "2018-01-01 14:00/2018-01-01 16:00", "2018-01-02 10:00/..")
+	other := spanSet("2018-01-01 11:00/2018-01-01 14:30", "2018-01-01 15:00/2018-01-01 15:15", "2018-01-02 12:00/2018-01-02 13:00")
+
+	assert.Equal(t, spanSet("2018-01-01 10:00/2018-01-01 16:00", "2018-01-02 10:00/.."), set.Union(other))
+	assert.Equal(t, spanSet("2018-01-01 11:00/2018-01-01 12:00", "2018-01-01 14:00/2018-01-01 14:30",
+		"2018-01-01 15:00/2018-01-01 15:15", "2018-01-02 12:00/2018-01-02 13:00"), set.Intersect(other))
+	assert.Equal(t, set.Intersect(other), other.Intersect(set))
+	assert.Equal(t, spanSet("2018-01-01 10:00/2018-01-01 11:00", "2018-01-01 14:30/2018-01-01 15:00",
+		"2018-01-01 15:15/2018-01-01 16:00", "2018-01-02 10:00/2018-01-02 12:00", "2018-01-02 13:00/.."),
+		set.Difference(other))
+	assert.Equal(t, spanSet("2018-01-01 12:00/2018-01-01 14:00"), other.Difference(set))
+	assert.Equal(t, DateTimeSpanSet{}, set.Difference(set))
+	assert.Equal(t, spanSet("2018-01-01 10:00/2018-01-01 10:30", "2018-01-01 15:30/2018-01-01 16:00"),
+		set.Remove(MustParseDateTimeSpan("2018-01-01 10:30/2018-01-01 15:30"), MustParseDateTimeSpan("2018-01-01 17:00/..")))
+	assert.Equal(t, spanSet("2018-01-01 11:00/2018-01-01 12:00", "2018-01-01 14:00/2018-01-01 16:00", "2018-01-02 10:00/2018-01-02 11:00"),
+		set.Clip(MustParseDateTimeSpan("2018-01-01 11:00/2018-01-02 11:00")))
+}
+
+func TestDateTimeSpanSetFreeSlots(t *testing.T) {
+	busy := spanSet("2018-01-01 09:00/2018-01-01 10:00", "2018-01-01 10:15/2018-01-01 12:00", "2018-01-01 13:00/2018-01-01 16:30")
+	day := MustParseDateTimeSpan("2018-01-01 08:00/2018-01-01 17:00")
+
+	assert.Equal(t, spanSet("2018-01-01 08:00/2018-01-01 09:00", "2018-01-01 10:00/2018-01-01 10:15",
+		"2018-01-01 12:00/2018-01-01 13:00", "2018-01-01 16:30/2018-01-01 17:00"), busy.Complement(day))
+	assert.Equal(t, []DateTimeSpan{
+		MustParseDateTimeSpan("2018-01-01 08:00/2018-01-01 09:00"),
+		MustParseDateTimeSpan("2018-01-01 12:00/2018-01-01 13:00"),
+	}, busy.FreeSlots(day, Hour))
+	assert.Equal(t, []DateTimeSpan{MustParseDateTimeSpan("2018-01-01 16:30/..")},
+		busy.FreeSlots(MustParseDateTimeSpan("2018-01-01 13:00/.."), 8*Hour))
+	assert.Nil(t, busy.FreeSlots(MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 16:00"), 2*Hour))
+}
+
+func TestDateTimeSpanSetJSON(t *testing.T) {
+	set := spanSet("2018-01-01 10:00/2018-01-01 12:00", "2018-01-02 10:00/..")
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+
+	var parsed DateTimeSpanSet
+	assert.NoError(t, json.Unmarshal(data, &parsed))
+	assert.Equal(t, set, parsed)
+}
@@ -2,6 +2,8 @@ package time
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDateTimeSpan_Overlaps(t *testing.T) {
@@ -56,6 +58,74 @@ func TestDateTimeSpanContains(t *testing.T) {
 	}
 }
 
+func TestOpenDateTimeSpanRelations(t *testing.T) {
+	var tests = []struct {
+		span, other        string
+		overlaps, contains bool
+	}{
+		{"2018-01-01 10:00/..", "2018-01-01 09:00/2018-01-01 10:00", false, false},
+		{"2018-01-01 10:00/..", "2018-01-01 09:00/2018-01-01 10:01", true, false},
+		{"2018-01-01 10:00/..", "2030-01-01 09:00/2030-01-01 10:00", true, true},
+		{"2018-01-01 10:00/..", "2018-01-01 11:00/..", true, true},
+		{"2018-01-01 10:00/..", "../2018-01-01 11:00", true, false},
+		{"2018-01-01 10:00/..", "../2018-01-01 10:00", false, false},
+		{"../2018-01-01 10:00", "1990-01-01 09:00/1990-01-01 10:00", true, true},
+		{"../2018-01-01 10:00", "2018-01-01 10:00/2018-01-01 11:00", false, false},
+		{"../2018-01-01 10:00", "../2018-01-01 09:00", true, true},
+		{"../2018-01-01 10:00", "2018-01-01 09:00/..", true, false},
+		{"2018-01-01 09:00/2018-01-01 12:00", "2018-01-01 10:00/..", true, false},
+		{"2018-01-01 09:00/2018-01-01 12:00", "../2018-01-01 09:00", false, false},
+	}
+	for _, test := range tests {
+		span, other := MustParseDateTimeSpan(test.span), MustParseDateTimeSpan(test.other)
+		assert.Equal(t, test.overlaps, span.Overlaps(other), "%v overlaps %v", test.span, test.other)
+		assert.Equal(t, test.overlaps, other.Overlaps(span), "%v overlaps %v", test.other, test.span)
+		assert.Equal(t, test.contains, span.Contains(other), "%v contains %v", test.span, test.other)
+	}
+}
+
+func TestOpenDateTimeSpan(t *testing.T) {
+	openEnded := MustNewOpenDateTimeSpanFrom(MustParseLocalDateTime("2018-01-01 10:00"))
+	openStarted := MustNewOpenDateTimeSpanUntil(MustParseLocalDateTime("2018-01-01 12:00"))
+	assert.True(t, openEnded.IsOpen())
+	assert.True(t, openEnded.IsOpenEnded())
+	assert.False(t, openEnded.IsOpenStarted())
+	assert.True(t, openStarted.IsOpenStarted())
+	assert.False(t, openStarted.Empty())
+	assert.False(t, openStarted.NullableFrom().Valid)
+	_, err := NewOpenDateTimeSpanUntil(NullLocalDateTime)
+	assert.Equal(t, ErrPeriodInvalidParamNull, err)
+
+	_, bounded := openEnded.Duration()
+	assert.False(t, bounded)
+	duration, bounded := MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 11:30").Duration()
+	assert.True(t, bounded)
+	assert.Equal(t, 90*Minute, duration)
+
+	clipped, ok := openEnded.Clip(openStarted)
+	assert.True(t, ok)
+	assert.Equal(t, MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 12:00"), clipped)
+	clipped, ok = openEnded.Clip(MustParseDateTimeSpan("2018-01-01 08:00/2018-01-01 11:00"))
+	assert.True(t, ok)
+	assert.Equal(t, MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 11:00"), clipped)
+	_, ok = openStarted.Clip(MustParseDateTimeSpan("2018-01-01 12:00/.."))
+	assert.False(t, ok)
+
+	until, err := openStarted.Until(MustParseLocalDateTime("2018-01-01 11:00"))
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseDateTimeSpan("../2018-01-01 11:00"), until)
+
+	assert.Equal(t, []DateTimeSpan{
+		MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 11:00"),
+		MustParseDateTimeSpan("2018-01-01 12:00/.."),
+	}, openEnded.Subtract(MustParseDateTimeSpan("2018-01-01 11:00/2018-01-01 12:00")))
+	assert.Equal(t, []DateTimeSpan{MustParseDateTimeSpan("../2018-01-01 10:00")},
+		openStarted.Subtract(MustParseDateTimeSpan("2018-01-01 10:00/..")))
+	assert.Equal(t, []DateTimeSpan{MustParseDateTimeSpan("2018-01-01 12:00/..")},
+		openEnded.Subtract(openStarted))
+	assert.Equal(t, []DateTimeSpan{}, openEnded.Subtract(MustParseDateTimeSpan("2018-01-01 09:00/..")))
+}
+
 func testDateTimeSpanMethod(
 	t *testing.T,
 	test DateTimeSpanTest,
@@ -89,3 +159,59 @@ type DateTimeSpanTest struct {
 	datetimeEndOther   string
 	want               bool
 }
+
+func TestDateTimeSpanJSON(t *testing.T) {
+	span := NewDateTimeSpan(MustParseLocalDateTime("2018-01-01 10:00"), MustParseLocalDateTime("2018-01-01 11:30"))
+	JSON, err := span.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"from":"2018-01-01 10:00","to":"2018-01-01 11:30"}`, string(JSON))
+
+	var actual DateTimeSpan
+	assert.NoError(t, actual.UnmarshalJSON(JSON))
+	assert.Equal(t, span, actual)
+
+	open := MustNewOpenDateTimeSpanFrom(MustParseLocalDateTime("2018-01-01 10:00"))
+	JSON, err = open.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"from":"2018-01-01 10:00","to":null}`, string(JSON))
+	assert.NoError(t, actual.UnmarshalJSON(JSON))
+	assert.Equal(t, open, actual)
+
+	openStarted := MustNewOpenDateTimeSpanUntil(MustParseLocalDateTime("2018-01-01 10:00"))
+	JSON, err = openStarted.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"from":null,"to":"2018-01-01 10:00"}`, string(JSON))
+	assert.NoError(t, actual.UnmarshalJSON(JSON))
+	assert.Equal(t, openStarted, actual)
+
+	assert.Equal(t, ErrPeriodInvalidFromAfterTo,
+		actual.UnmarshalJSON([]byte(`{"from":"2018-01-01 12:00","to":"2018-01-01 11:30"}`)))
+	assert.Equal(t, ErrPeriodInvalidParamNull, actual.UnmarshalJSON([]byte(`{}`)))
+}
+
+func TestDateTimeSpanTextScanAndValue(t *testing.T) {
+	span := NewDateTimeSpan(MustParseLocalDateTime("2018-01-01 10:00"), MustParseLocalDateTime("2018-01-01 11:30"))
+	text, _ := span.MarshalText()
+	assert.Equal(t, "2018-01-01 10:00/2018-01-01 11:30", string(text))
+
+	var actual DateTimeSpan
+	assert.NoError(t, actual.UnmarshalText(text))
+	assert.Equal(t, span, actual)
+	assert.NoError(t, actual.Scan([]byte("2018-01-01 10:00/..")))
+	assert.Equal(t, MustNewOpenDateTimeSpanFrom(MustParseLocalDateTime("2018-01-01 10:00")), actual)
+	assert.Error(t, actual.Scan("2018-01-01 12:00/2018-01-01 11:30"))
+	assert.Error(t, actual.Scan(nil))
+
+	value, err := actual.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `["2018-01-01 10:00:00",)`, value)
+
+	assert.NoError(t, actual.UnmarshalText([]byte("../2018-01-01 10:00")))
+	assert.Equal(t, MustNewOpenDateTimeSpanUntil(MustParseLocalDateTime("2018-01-01 10:00")), actual)
+	text, _ = actual.MarshalText()
+	assert.Equal(t, "../2018-01-01 10:00", string(text))
+	assert.Error(t, actual.UnmarshalText([]byte("../..")))
+	value, err = actual.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `(,"2018-01-01 10:00:00")`, value)
+}
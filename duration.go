@@ -1,6 +1,15 @@
 package time
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+)
 
 // A Duration represents the elapsed time between two instants
 // as an int64 nanosecond count. The representation limits the
@@ -18,6 +27,354 @@ const (
 	Hour                 = 60 * Minute
 )
 
+// DurationStyle selects the textual form of Duration in FormatStyle
+type DurationStyle int
+
+const (
+	// DurationStyleNanoseconds renders duration as a number of nanoseconds, as encoding/json does for time.Duration
+	DurationStyleNanoseconds DurationStyle = iota
+	// DurationStyleGo renders duration as in time.Duration.String, e.g. "1h30m0s"
+	DurationStyleGo
+	// DurationStyleISO renders duration in ISO 8601, e.g. "PT1H30M"
+	DurationStyleISO
+)
+
 func (d Duration) String() string {
 	return time.Duration(d).String()
 }
+
+// ParseDuration parses duration in Go syntax, e.g. "1h30m" or "-1.5s",
+// or in ISO 8601, e.g. "PT1H30M", "PT0.5S" or "-P1DT2H", where a day is 24 hours.
+func ParseDuration(value string) (Duration, error) {
+	if strings.HasPrefix(strings.TrimLeft(strings.ToUpper(value), "+-"), "P") {
+		return parseISODuration(value)
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	return Duration(d), nil
+}
+
+// MustParseDuration is like ParseDuration but panics on error
+func MustParseDuration(value string) Duration {
+	d, err := ParseDuration(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// parseISODuration parses ISO 8601 duration in days, hours, minutes and seconds.
+// Components may have their own sign, e.g. "PT1H-30M", and seconds may have a fraction.
+func parseISODuration(value string) (Duration, error) {
+	wrongFormat := fmt.Errorf("Wrong Duration format: %v", value)
+	text := strings.ToUpper(value)
+	sign := Duration(1)
+	switch {
+	case strings.HasPrefix(text, "-"):
+		sign = -1
+		text = text[1:]
+	case strings.HasPrefix(text, "+"):
+		text = text[1:]
+	}
+	if !strings.HasPrefix(text, "P") {
+		return 0, wrongFormat
+	}
+	datePart, timePart := text[1:], ""
+	if t := strings.IndexByte(datePart, 'T'); t >= 0 {
+		datePart, timePart = datePart[:t], datePart[t+1:]
+		if timePart == "" {
+			return 0, wrongFormat
+		}
+	}
+	if datePart == "" && timePart == "" {
+		return 0, wrongFormat
+	}
+
+	var total Duration
+	for _, part := range []struct {
+		text        string
+		designators string
+		units       []Duration
+	}{
+		{datePart, "D", []Duration{24 * Hour}},
+		{timePart, "HMS", []Duration{Hour, Minute, Second}},
+	} {
+		text, designators, units := part.text, part.designators, part.units
+		for text != "" {
+			end := strings.IndexAny(text, designators)
+			if end <= 0 {
+				return 0, wrongFormat
+			}
+			designator := strings.IndexByte(designators, text[end])
+			var component Duration
+			var err error
+			if designators[designator] == 'S' {
+				component, err = parseISOSeconds(text[:end])
+			} else {
+				var number int64
+				number, err = strconv.ParseInt(text[:end], 10, 64)
+				if err == nil && (number > math.MaxInt64/int64(units[designator]) || number < math.MinInt64/int64(units[designator])) {
+					err = wrongFormat
+				}
+				component = Duration(number) * units[designator]
+			}
+			if err != nil {
+				return 0, wrongFormat
+			}
+			total += component
+			// designators must come in order and only once
+			designators, units = designators[designator+1:], units[designator+1:]
+			text = text[end+1:]
+		}
+	}
+	return sign * total, nil
+}
+
+// parseISOSeconds parses seconds with optional fraction, e.g. "-1.5" or "0,25"
+func parseISOSeconds(value string) (Duration, error) {
+	value = strings.Replace(value, ",", ".", 1)
+	negative := strings.HasPrefix(value, "-")
+	whole, fraction := strings.TrimLeft(value, "+-"), ""
+	if dot := strings.IndexByte(whole, '.'); dot >= 0 {
+		whole, fraction = whole[:dot], whole[dot+1:]
+		if len(fraction) == 0 || len(fraction) > 9 || strings.Trim(fraction, "0123456789") != "" {
+			return 0, fmt.Errorf("Wrong seconds format: %v", value)
+		}
+	}
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || seconds > math.MaxInt64/int64(Second)-1 {
+		return 0, fmt.Errorf("Wrong seconds format: %v", value)
+	}
+	nanos := 0
+	if fraction != "" {
+		nanos, _ = strconv.Atoi(fraction + strings.Repeat("0", 9-len(fraction)))
+	}
+	d := Duration(seconds)*Second + Duration(nanos)
+	if negative {
+		return -d, nil
+	}
+	return d, nil
+}
+
+// ISOString formats duration in ISO 8601 in hours, minutes and seconds, e.g. "PT36H0.5S".
+// Negative duration has all components negative, e.g. "PT-1H-30M", which is accepted by PostgreSQL.
+// Zero duration is "PT0S".
+func (d Duration) ISOString() string {
+	if d == 0 {
+		return "PT0S"
+	}
+	sign := ""
+	abs := uint64(d)
+	if d < 0 {
+		sign = "-"
+		abs = uint64(-d)
+	}
+	hours := abs / uint64(Hour)
+	minutes := abs % uint64(Hour) / uint64(Minute)
+	seconds := abs % uint64(Minute) / uint64(Second)
+	nanos := abs % uint64(Second)
+
+	var sb strings.Builder
+	sb.WriteString("PT")
+	if hours != 0 {
+		sb.WriteString(sign + strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes != 0 {
+		sb.WriteString(sign + strconv.FormatUint(minutes, 10) + "M")
+	}
+	if seconds != 0 || nanos != 0 {
+		sb.WriteString(sign + strconv.FormatUint(seconds, 10))
+		if nanos != 0 {
+			sb.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0"))
+		}
+		sb.WriteString("S")
+	}
+	return sb.String()
+}
+
+// FormatStyle renders duration in given style
+func (d Duration) FormatStyle(style DurationStyle) string {
+	switch style {
+	case DurationStyleGo:
+		return d.String()
+	case DurationStyleISO:
+		return d.ISOString()
+	}
+	return strconv.FormatInt(int64(d), 10)
+}
+
+// GoDuration converts Duration to time.Duration
+func (d Duration) GoDuration() time.Duration {
+	return time.Duration(d)
+}
+
+// Hours returns the duration as a floating point number of hours
+func (d Duration) Hours() float64 {
+	return time.Duration(d).Hours()
+}
+
+// Minutes returns the duration as a floating point number of minutes
+func (d Duration) Minutes() float64 {
+	return time.Duration(d).Minutes()
+}
+
+// Seconds returns the duration as a floating point number of seconds
+func (d Duration) Seconds() float64 {
+	return time.Duration(d).Seconds()
+}
+
+// Milliseconds returns the duration as an integer millisecond count
+func (d Duration) Milliseconds() int64 {
+	return int64(d / Millisecond)
+}
+
+// Microseconds returns the duration as an integer microsecond count
+func (d Duration) Microseconds() int64 {
+	return int64(d / Microsecond)
+}
+
+// Nanoseconds returns the duration as an integer nanosecond count
+func (d Duration) Nanoseconds() int64 {
+	return int64(d)
+}
+
+// Truncate returns the result of rounding d toward zero to a multiple of m, d if m <= 0
+func (d Duration) Truncate(m Duration) Duration {
+	return Duration(time.Duration(d).Truncate(time.Duration(m)))
+}
+
+// Round returns the result of rounding d to the nearest multiple of m, halfway values away from zero.
+// It returns d if m <= 0.
+func (d Duration) Round(m Duration) Duration {
+	return Duration(time.Duration(d).Round(time.Duration(m)))
+}
+
+// Abs returns the absolute value of d. The most negative duration is converted to the most positive one.
+func (d Duration) Abs() Duration {
+	return Duration(time.Duration(d).Abs())
+}
+
+// Mul returns duration multiplied by n
+func (d Duration) Mul(n int64) Duration {
+	return d * Duration(n)
+}
+
+// Scale returns duration multiplied by factor, rounded to nanoseconds
+func (d Duration) Scale(factor float64) Duration {
+	return Duration(math.Round(float64(d) * factor))
+}
+
+// Div returns duration divided by n, truncated toward zero
+func (d Duration) Div(n int64) Duration {
+	return d / Duration(n)
+}
+
+// DivDuration returns how many times other fits in d, truncated toward zero, e.g. 2 for 2h30m and 1h
+func (d Duration) DivDuration(other Duration) int64 {
+	return int64(d / other)
+}
+
+// MarshalJSON marshals duration to JSON number of nanoseconds, as encoding/json does for time.Duration.
+// Use ISODuration to marshal it to ISO 8601 string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(d), 10), nil
+}
+
+// UnmarshalJSON parses JSON number of nanoseconds or string accepted by ParseDuration into duration
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	d.unmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (d *Duration) unmarshalEasyJSON(in *jlexer.Lexer) {
+	if in.CurrentToken() == jlexer.TokenNumber {
+		if nanoseconds := in.Int64(); in.Ok() {
+			*d = Duration(nanoseconds)
+		}
+		return
+	}
+	if data := in.String(); in.Ok() {
+		parsed, err := ParseDuration(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*d = parsed
+	}
+}
+
+// MarshalText serializes duration to number of nanoseconds
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.FormatStyle(DurationStyleNanoseconds)), nil
+}
+
+// UnmarshalText parses number of nanoseconds or string accepted by ParseDuration into duration
+func (d *Duration) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	if nanoseconds, err := strconv.ParseInt(string(text), 10, 64); err == nil {
+		*d = Duration(nanoseconds)
+		return nil
+	}
+	parsed, err := ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// ISODuration is a Duration which is marshalled to JSON and text in ISO 8601, e.g. "PT1H30M".
+// Unmarshalling accepts the same forms as Duration.
+type ISODuration Duration
+
+// MarshalJSON marshals duration to JSON string in ISO 8601
+func (d ISODuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Duration(d).ISOString())
+}
+
+// UnmarshalJSON parses JSON number of nanoseconds or string accepted by ParseDuration into duration
+func (d *ISODuration) UnmarshalJSON(data []byte) error {
+	return (*Duration)(d).UnmarshalJSON(data)
+}
+
+// MarshalText serializes duration in ISO 8601
+func (d ISODuration) MarshalText() ([]byte, error) {
+	return []byte(Duration(d).ISOString()), nil
+}
+
+// UnmarshalText parses number of nanoseconds or string accepted by ParseDuration into duration
+func (d *ISODuration) UnmarshalText(text []byte) error {
+	return (*Duration)(d).UnmarshalText(text)
+}
+
+// Scan implements the Scanner interface.
+// It accepts PostgreSQL interval without years and months, where a day is 24 hours,
+// e.g. "1 day 02:30:00", strings accepted by ParseDuration and integer number of nanoseconds.
+func (d *Duration) Scan(value interface{}) error {
+	if nanoseconds, ok := value.(int64); ok {
+		*d = Duration(nanoseconds)
+		return nil
+	}
+	text, err := scanText("Duration", value)
+	if err != nil {
+		return err
+	}
+	if parsed, err := ParseDuration(text); err == nil {
+		*d = parsed
+		return nil
+	}
+	interval, err := parsePgInterval(text)
+	if err == nil && interval.amount.TotalMonths() != 0 {
+		err = fmt.Errorf("Interval has years or months, which have no fixed length: %v", text)
+	}
+	if err != nil {
+		return fmt.Errorf("sql: failed to scan into Duration: %v", err)
+	}
+	*d = Duration(interval.amount.days)*24*Hour + interval.time
+	return nil
+}
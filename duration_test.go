@@ -0,0 +1,138 @@
+package time
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Duration
+	}{
+		{"1h30m", Hour + 30*Minute},
+		{"-1.5s", -1500 * Millisecond},
+		{"PT1H30M", Hour + 30*Minute},
+		{"pt0.5s", 500 * Millisecond},
+		{"PT0,000000001S", Nanosecond},
+		{"P1DT2H", 26 * Hour},
+		{"P2D", 48 * Hour},
+		{"-PT1H", -Hour},
+		{"PT1H-30M", 30 * Minute},
+		{"PT-1H-30M-0.5S", -(Hour + 30*Minute + 500*Millisecond)},
+		{"PT36H", 36 * Hour},
+	}
+	for _, test := range tests {
+		actual, err := ParseDuration(test.value)
+		assert.NoError(t, err, test.value)
+		assert.Equal(t, test.expected, actual, test.value)
+	}
+
+	for _, value := range []string{"", "1x", "P", "PT", "P1H", "PT1D", "P1Y", "P1M", "PT1S1M", "PT1.5M", "PT1.S", "PT9999999999H"} {
+		_, err := ParseDuration(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestDurationISOString(t *testing.T) {
+	assert.Equal(t, "PT0S", Duration(0).ISOString())
+	assert.Equal(t, "PT1H30M", (Hour + 30*Minute).ISOString())
+	assert.Equal(t, "PT36H0.5S", (36*Hour + 500*Millisecond).ISOString())
+	assert.Equal(t, "PT0.000000001S", Nanosecond.ISOString())
+	assert.Equal(t, "PT-1H-30M", (-Hour - 30*Minute).ISOString())
+	assert.Equal(t, "PT-1.5S", (-1500 * Millisecond).ISOString())
+	minimal := Duration(-1 << 63)
+	assert.Equal(t, minimal, MustParseDuration(minimal.ISOString()))
+}
+
+func TestDurationOperations(t *testing.T) {
+	d := 2*Hour + 30*Minute + 40*Second + 500*Millisecond
+	assert.Equal(t, 2.5+40.5/3600, d.Hours())
+	assert.Equal(t, 150+40.5/60, d.Minutes())
+	assert.Equal(t, 9040.5, d.Seconds())
+	assert.Equal(t, int64(9040500), d.Milliseconds())
+	assert.Equal(t, int64(9040500000), d.Microseconds())
+	assert.Equal(t, int64(9040500000000), d.Nanoseconds())
+	assert.Equal(t, 2*Hour+30*Minute, d.Truncate(Minute))
+	assert.Equal(t, 2*Hour+31*Minute, d.Round(Minute))
+	assert.Equal(t, 3*Hour, d.Round(Hour))
+	assert.Equal(t, d, (-d).Abs())
+	assert.Equal(t, 5*Hour+Minute+21*Second, d.Mul(2))
+	assert.Equal(t, Hour+15*Minute+20*Second+250*Millisecond, d.Div(2))
+	assert.Equal(t, 3*Hour+45*Minute+60*Second+750*Millisecond, d.Scale(1.5))
+	assert.Equal(t, int64(150), d.DivDuration(Minute))
+	assert.Equal(t, 2*time.Hour+30*time.Minute+40*time.Second+500*time.Millisecond, d.GoDuration())
+}
+
+func TestDurationJSON(t *testing.T) {
+	type timeout struct {
+		After Duration `json:"after"`
+	}
+	JSON, err := json.Marshal(timeout{After: 90 * Second})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"after":90000000000}`, string(JSON))
+
+	text, err := (90 * Second).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "90000000000", string(text))
+
+	type isoTimeout struct {
+		After ISODuration `json:"after"`
+	}
+	JSON, err = json.Marshal(isoTimeout{After: ISODuration(90 * Second)})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"after":"PT1M30S"}`, string(JSON))
+	var iso isoTimeout
+	assert.NoError(t, json.Unmarshal(JSON, &iso))
+	assert.Equal(t, ISODuration(90*Second), iso.After)
+	text, err = ISODuration(90 * Second).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "PT1M30S", string(text))
+
+	for _, value := range []string{`90000000000`, `"1m30s"`, `"PT1M30S"`} {
+		var actual timeout
+		assert.NoError(t, json.Unmarshal([]byte(`{"after":`+value+`}`), &actual), value)
+		assert.Equal(t, 90*Second, actual.After, value)
+	}
+	var actual timeout
+	assert.Error(t, json.Unmarshal([]byte(`{"after":"soon"}`), &actual))
+	assert.Error(t, json.Unmarshal([]byte(`{"after":1.5}`), &actual))
+
+	var d Duration
+	assert.NoError(t, d.UnmarshalText([]byte("90000000000")))
+	assert.Equal(t, 90*Second, d)
+	assert.NoError(t, d.UnmarshalText([]byte("PT1M")))
+	assert.Equal(t, Minute, d)
+}
+
+func TestDurationScan(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected Duration
+	}{
+		{"01:30:00", Hour + 30*Minute},
+		{[]byte("1 day 02:00:00.5"), 26*Hour + 500*Millisecond},
+		{"-00:00:01.25", -1250 * Millisecond},
+		{"36:00:00", 36 * Hour},
+		{"@ 1 hour 30 mins ago", -(Hour + 30*Minute)},
+		{"@ 2 days 1.5 secs", 48*Hour + 1500*Millisecond},
+		{"PT1H30M", Hour + 30*Minute},
+		{"P1DT-1H", 23 * Hour},
+		{"1h30m", Hour + 30*Minute},
+		{int64(1000), Microsecond},
+	}
+	for _, test := range tests {
+		var d Duration
+		assert.NoError(t, d.Scan(test.value), "%v", test.value)
+		assert.Equal(t, test.expected, d, "%v", test.value)
+	}
+
+	var d Duration
+	assert.Error(t, d.Scan("1 mon 00:00:00"))
+	assert.Error(t, d.Scan("P1Y"))
+	assert.Error(t, d.Scan("sometime"))
+	assert.Error(t, d.Scan(nil))
+}
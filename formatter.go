@@ -0,0 +1,553 @@
+package time
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Formatter formats and parses local date and time types using CLDR (Java DateTimeFormatter)
+// style pattern, e.g. "dd.MM.yyyy", "d MMMM yyyy", "yyyyMMdd" or "yyyy-MM-dd'T'HH:mm:ss".
+//
+// Supported pattern letters:
+//
+//	y  year, "yy" is a two-digit year in 2000-2099
+//	M  month, "M" and "MM" numeric, "MMM" short and "MMMM" full name
+//	L  standalone month, like "M" but "LLLL" is the nominative form in languages like Polish
+//	d  day of month
+//	E  day of week, "E" to "EEE" short and "EEEE" full name
+//	H  hour of day (0-23)
+//	h  clock hour of am/pm (1-12)
+//	a  am/pm marker
+//	m  minute
+//	s  second
+//	S  fraction of second, the number of letters is the number of digits
+//
+// Names are taken from the formatter's locale, English unless set with WithLocale.
+// Parsing names ignores case and accepts both format and standalone month names.
+//
+// Text in single quotes is copied verbatim and two apostrophes in a row stand for one apostrophe,
+// also within quoted text, e.g. for 5 o'clock:
+//
+//	h 'o''clock'
+//
+// Other ASCII letters are reserved, any other character is a literal.
+//
+// Numeric fields of a single letter are parsed with as many digits as the field allows,
+// longer fields require exactly that many digits, so adjacent fields like in "yyyyMMdd" can be parsed.
+// Parsing is strict: the whole value must match the pattern and fields must be in range.
+type Formatter struct {
+	pattern string
+	fields  []patternField
+	locale  *Locale
+}
+
+// patternField is a single field or literal of compiled pattern
+type patternField struct {
+	letter  byte // 0 for literal
+	width   int
+	literal string
+}
+
+// dateTimeFields holds values of all pattern fields to format
+type dateTimeFields struct {
+	year       int
+	month      time.Month
+	day        int
+	weekday    Weekday
+	hour       int
+	minute     int
+	second     int
+	nanosecond int
+}
+
+const (
+	dateFieldLetters = "yMLdE"
+	timeFieldLetters = "Hhams"
+	// fractionFieldLetter is not supported by LocalTime, which has minute precision
+	fractionFieldLetter = "S"
+)
+
+// formatterCache holds compiled formatters by pattern.
+// Patterns are expected to be constants, so the cache is never evicted.
+var formatterCache sync.Map
+
+// NewFormatter compiles pattern into Formatter. Compiled formatters are cached,
+// so it is cheap to call it for every value.
+func NewFormatter(pattern string) (*Formatter, error) {
+	if cached, ok := formatterCache.Load(pattern); ok {
+		return cached.(*Formatter), nil
+	}
+	fields, err := compilePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	formatter, _ := formatterCache.LoadOrStore(pattern, &Formatter{pattern: pattern, fields: fields})
+	return formatter.(*Formatter), nil
+}
+
+// MustNewFormatter is like NewFormatter but panics on error
+func MustNewFormatter(pattern string) *Formatter {
+	formatter, err := NewFormatter(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return formatter
+}
+
+// Pattern returns the pattern formatter was compiled from
+func (f *Formatter) Pattern() string {
+	return f.pattern
+}
+
+// WithLocale returns formatter with the same pattern using names from given locale
+func (f *Formatter) WithLocale(locale *Locale) *Formatter {
+	localized := *f
+	localized.locale = locale
+	return &localized
+}
+
+// Locale returns locale of month and weekday names
+func (f *Formatter) Locale() *Locale {
+	if f.locale == nil {
+		return EnglishLocale()
+	}
+	return f.locale
+}
+
+// names returns locale of month and weekday names without copying English one
+func (f *Formatter) names() *Locale {
+	if f.locale == nil {
+		return &english
+	}
+	return f.locale
+}
+
+func compilePattern(pattern string) ([]patternField, error) {
+	var fields []patternField
+	appendLiteral := func(literal string) {
+		if last := len(fields) - 1; last >= 0 && fields[last].letter == 0 {
+			fields[last].literal += literal
+			return
+		}
+		fields = append(fields, patternField{literal: literal})
+	}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			if i+1 < len(pattern) && pattern[i+1] == '\'' {
+				appendLiteral("'")
+				i += 2
+				continue
+			}
+			var literal strings.Builder
+			end := i + 1
+			for ; ; end++ {
+				if end >= len(pattern) {
+					return nil, fmt.Errorf("Wrong pattern %q: unterminated quote at position %d", pattern, i)
+				}
+				if pattern[end] == '\'' {
+					if end+1 < len(pattern) && pattern[end+1] == '\'' {
+						literal.WriteByte('\'')
+						end++
+						continue
+					}
+					break
+				}
+				literal.WriteByte(pattern[end])
+			}
+			appendLiteral(literal.String())
+			i = end + 1
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			end := i
+			for end < len(pattern) && pattern[end] == c {
+				end++
+			}
+			width := end - i
+			if maxWidth := maxPatternFieldWidth(c); width > maxWidth {
+				if maxWidth == 0 {
+					return nil, fmt.Errorf("Wrong pattern %q: unsupported field %q at position %d", pattern, string(c), i)
+				}
+				return nil, fmt.Errorf("Wrong pattern %q: field %q is too long at position %d", pattern, pattern[i:end], i)
+			}
+			fields = append(fields, patternField{letter: c, width: width})
+			i = end
+		default:
+			appendLiteral(string(c))
+			i++
+		}
+	}
+	return fields, nil
+}
+
+// maxPatternFieldWidth returns the longest supported field for letter, 0 if letter is not supported
+func maxPatternFieldWidth(letter byte) int {
+	switch letter {
+	case 'y', 'S':
+		return 9
+	case 'M', 'L', 'E':
+		return 4
+	case 'd', 'H', 'h', 'm', 's':
+		return 2
+	case 'a':
+		return 1
+	}
+	return 0
+}
+
+// FormatDate formats date. It fails if pattern contains time fields.
+func (f *Formatter) FormatDate(d LocalDate) (string, error) {
+	year, month, day := d.Date()
+	return f.format(dateTimeFields{year: year, month: month, day: day, weekday: d.Weekday()}, dateFieldLetters, "LocalDate")
+}
+
+// FormatTime formats time. It fails if pattern contains date fields.
+// Seconds and fraction of a second are rendered as zeros.
+func (f *Formatter) FormatTime(t LocalTime) (string, error) {
+	return f.format(dateTimeFields{hour: t.Hour(), minute: t.Minutes()}, timeFieldLetters+fractionFieldLetter, "LocalTime")
+}
+
+// FormatDateTime formats date-time
+func (f *Formatter) FormatDateTime(ldt LocalDateTime) (string, error) {
+	year, month, day := ldt.t.Date()
+	return f.format(dateTimeFields{
+		year:       year,
+		month:      month,
+		day:        day,
+		weekday:    ldt.Weekday(),
+		hour:       ldt.t.Hour(),
+		minute:     ldt.t.Minute(),
+		second:     ldt.t.Second(),
+		nanosecond: ldt.t.Nanosecond(),
+	}, dateFieldLetters+timeFieldLetters+fractionFieldLetter, "LocalDateTime")
+}
+
+// FormatWeekday formats weekday. Pattern may contain only day of week fields.
+func (f *Formatter) FormatWeekday(w Weekday) (string, error) {
+	if w < Sunday || w > Saturday {
+		return "", fmt.Errorf("Wrong Weekday: %d", w)
+	}
+	return f.format(dateTimeFields{weekday: w}, "E", "Weekday")
+}
+
+func (f *Formatter) format(values dateTimeFields, letters, typeName string) (string, error) {
+	locale := f.names()
+	var sb strings.Builder
+	for _, field := range f.fields {
+		if field.letter == 0 {
+			sb.WriteString(field.literal)
+			continue
+		}
+		if strings.IndexByte(letters, field.letter) < 0 {
+			return "", f.unsupportedFieldError(field, typeName)
+		}
+		switch field.letter {
+		case 'y':
+			if field.width == 2 {
+				writePaddedNumber(&sb, int(floorMod(int64(values.year), 100)), 2)
+			} else {
+				writePaddedNumber(&sb, values.year, field.width)
+			}
+		case 'M', 'L':
+			if field.width >= 3 {
+				sb.WriteString(locale.monthNames(field, false)[0][values.month-1])
+			} else {
+				writePaddedNumber(&sb, int(values.month), field.width)
+			}
+		case 'd':
+			writePaddedNumber(&sb, values.day, field.width)
+		case 'E':
+			sb.WriteString(locale.weekdayNames(field)[values.weekday])
+		case 'H':
+			writePaddedNumber(&sb, values.hour, field.width)
+		case 'h':
+			writePaddedNumber(&sb, (values.hour+11)%12+1, field.width)
+		case 'a':
+			sb.WriteString(locale.AmPmMarkers[values.hour/12])
+		case 'm':
+			writePaddedNumber(&sb, values.minute, field.width)
+		case 's':
+			writePaddedNumber(&sb, values.second, field.width)
+		case 'S':
+			writePaddedNumber(&sb, values.nanosecond/pow10(9-field.width), field.width)
+		}
+	}
+	return sb.String(), nil
+}
+
+// ParseDate parses date. Pattern must contain year, month and day and no time fields.
+// Day of week, if present, must match the date.
+func (f *Formatter) ParseDate(value string) (LocalDate, error) {
+	parsed, err := f.parse(value, dateFieldLetters, "LocalDate")
+	if err != nil {
+		return NullLocalDate, err
+	}
+	return f.resolveDate(value, parsed)
+}
+
+// ParseTime parses time. Pattern must contain hour and no date fields.
+// Seconds and fraction of a second must be zero, since LocalTime has minute precision.
+func (f *Formatter) ParseTime(value string) (LocalTime, error) {
+	parsed, err := f.parse(value, timeFieldLetters+fractionFieldLetter, "LocalTime")
+	if err != nil {
+		return NullLocalTime, err
+	}
+	hour, err := f.resolveHour(value, parsed)
+	if err != nil {
+		return NullLocalTime, err
+	}
+	if parsed['s'] != 0 || parsed['S'] != 0 {
+		return NullLocalTime, f.parseError(value, -1, "LocalTime can't have seconds")
+	}
+	return NewLocalTime(hour, parsed['m'])
+}
+
+// ParseDateTime parses date-time. Pattern must contain year, month, day and hour.
+func (f *Formatter) ParseDateTime(value string) (LocalDateTime, error) {
+	parsed, err := f.parse(value, dateFieldLetters+timeFieldLetters+fractionFieldLetter, "LocalDateTime")
+	if err != nil {
+		return NullLocalDateTime, err
+	}
+	date, err := f.resolveDate(value, parsed)
+	if err != nil {
+		return NullLocalDateTime, err
+	}
+	hour, err := f.resolveHour(value, parsed)
+	if err != nil {
+		return NullLocalDateTime, err
+	}
+	year, month, day := date.Date()
+	return NewLocalDateTime(time.Date(year, month, day, hour, parsed['m'], parsed['s'], parsed['S'], time.UTC)), nil
+}
+
+// ParseWeekday parses weekday. Pattern may contain only day of week fields.
+func (f *Formatter) ParseWeekday(value string) (Weekday, error) {
+	parsed, err := f.parse(value, "E", "Weekday")
+	if err != nil {
+		return NotAWeekday, err
+	}
+	weekday, ok := parsed['E']
+	if !ok {
+		return NotAWeekday, fmt.Errorf("Pattern %q doesn't contain day of week", f.pattern)
+	}
+	return Weekday(weekday), nil
+}
+
+func (f *Formatter) resolveDate(value string, parsed map[byte]int) (LocalDate, error) {
+	year, hasYear := parsed['y']
+	month, hasMonth := parsed['M']
+	day, hasDay := parsed['d']
+	if !hasYear || !hasMonth || !hasDay {
+		return NullLocalDate, fmt.Errorf("Pattern %q doesn't contain year, month and day", f.pattern)
+	}
+	date := NewLocalDate(year, time.Month(month), day)
+	if _, _, actualDay := date.Date(); actualDay != day {
+		return NullLocalDate, f.parseError(value, -1, "day %d is out of range for %v %d", day, time.Month(month), year)
+	}
+	if weekday, ok := parsed['E']; ok && date.Weekday() != Weekday(weekday) {
+		return NullLocalDate, f.parseError(value, -1, "%v is %v, not %v", date, date.Weekday(), Weekday(weekday))
+	}
+	return date, nil
+}
+
+// resolveHour combines hour of day, clock hour and am/pm marker
+func (f *Formatter) resolveHour(value string, parsed map[byte]int) (int, error) {
+	hour, hasHour := parsed['H']
+	clockHour, hasClockHour := parsed['h']
+	amPm, hasAmPm := parsed['a']
+	if hasClockHour != hasAmPm {
+		return 0, fmt.Errorf("Pattern %q must contain both clock hour and am/pm marker or none of them", f.pattern)
+	}
+	if !hasHour && !hasClockHour {
+		return 0, fmt.Errorf("Pattern %q doesn't contain hour", f.pattern)
+	}
+	if !hasClockHour {
+		return hour, nil
+	}
+	if hasHour && hour != clockHour%12+amPm*12 {
+		return 0, f.parseError(value, -1, "hour %d doesn't match %d %v", hour, clockHour, f.names().AmPmMarkers[amPm])
+	}
+	return clockHour%12 + amPm*12, nil
+}
+
+// parse matches value against the pattern and returns numeric values of fields by pattern letter.
+// Months are numbered from 1, weekdays from Sunday as 0, am/pm marker is 0 for AM and 1 for PM
+// and fraction of a second is in nanoseconds.
+func (f *Formatter) parse(value, letters, typeName string) (map[byte]int, error) {
+	locale := f.names()
+	parsed := map[byte]int{}
+	pos := 0
+	for i, field := range f.fields {
+		if field.letter == 0 {
+			if !strings.HasPrefix(value[pos:], field.literal) {
+				return nil, f.parseError(value, pos, "expected %q", field.literal)
+			}
+			pos += len(field.literal)
+			continue
+		}
+		if strings.IndexByte(letters, field.letter) < 0 {
+			return nil, f.unsupportedFieldError(field, typeName)
+		}
+
+		var number int
+		start := pos
+		switch {
+		case (field.letter == 'M' || field.letter == 'L') && field.width >= 3:
+			index, length := -1, 0
+			for _, names := range locale.monthNames(field, true) {
+				if i, l := matchName(value[pos:], names[:]); l > length {
+					index, length = i, l
+				}
+			}
+			if index < 0 {
+				return nil, f.parseError(value, pos, "expected month name")
+			}
+			number = index + 1
+			pos += length
+		case field.letter == 'E':
+			names := locale.weekdayNames(field)
+			index, length := matchName(value[pos:], names[:])
+			if index < 0 {
+				return nil, f.parseError(value, pos, "expected day of week name")
+			}
+			number = index
+			pos += length
+		case field.letter == 'a':
+			index, length := matchName(value[pos:], locale.AmPmMarkers[:])
+			if index < 0 {
+				return nil, f.parseError(value, pos, "expected AM or PM")
+			}
+			number = index
+			pos += length
+		default:
+			minDigits, maxDigits := field.width, field.width
+			if field.width == 1 && field.letter != 'S' {
+				maxDigits = 2
+				if field.letter == 'y' {
+					maxDigits = 9
+				}
+				if i+1 < len(f.fields) && f.fields[i+1].letter != 0 && !isTextField(f.fields[i+1]) {
+					// adjacent numeric field takes the rest of digits
+					maxDigits = 1
+				}
+			}
+			end := pos
+			for end < len(value) && end-pos < maxDigits && value[end] >= '0' && value[end] <= '9' {
+				end++
+			}
+			if end-pos < minDigits {
+				return nil, f.parseError(value, pos, "expected %d digit(s) of %q", minDigits, patternFieldString(field))
+			}
+			number, _ = strconv.Atoi(value[pos:end])
+			pos = end
+			switch {
+			case field.letter == 'y' && field.width == 2:
+				number += 2000
+			case field.letter == 'S':
+				number *= pow10(9 - field.width)
+			}
+			if min, max := patternFieldRange(field.letter); number < min || number > max {
+				return nil, f.parseError(value, start, "%q must be between %d and %d, was %d",
+					patternFieldString(field), min, max, number)
+			}
+		}
+
+		letter := field.letter
+		if letter == 'L' {
+			letter = 'M'
+		}
+		if previous, ok := parsed[letter]; ok && previous != number {
+			return nil, f.parseError(value, start, "%q conflicts with earlier value", patternFieldString(field))
+		}
+		parsed[letter] = number
+	}
+	if pos != len(value) {
+		return nil, f.parseError(value, pos, "unexpected text %q", value[pos:])
+	}
+	return parsed, nil
+}
+
+// patternFieldRange returns valid values of numeric field
+func patternFieldRange(letter byte) (int, int) {
+	switch letter {
+	case 'M', 'L':
+		return 1, 12
+	case 'd':
+		return 1, 31
+	case 'H':
+		return 0, 23
+	case 'h':
+		return 1, 12
+	case 'm', 's':
+		return 0, 59
+	}
+	return 0, math.MaxInt32
+}
+
+func (f *Formatter) unsupportedFieldError(field patternField, typeName string) error {
+	return fmt.Errorf("Pattern %q field %q is not supported by %v", f.pattern, patternFieldString(field), typeName)
+}
+
+func (f *Formatter) parseError(value string, offset int, format string, args ...interface{}) error {
+	return &PatternParseError{Pattern: f.pattern, Value: value, Offset: offset, Message: fmt.Sprintf(format, args...)}
+}
+
+// PatternParseError describes a value which doesn't match Formatter's pattern
+type PatternParseError struct {
+	Pattern string
+	Value   string
+	// Offset is the position in Value at which parsing failed, -1 if the error is not related to a position
+	Offset  int
+	Message string
+}
+
+func (e *PatternParseError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("Cannot parse %q as %q: %v", e.Value, e.Pattern, e.Message)
+	}
+	return fmt.Sprintf("Cannot parse %q as %q at position %d: %v", e.Value, e.Pattern, e.Offset, e.Message)
+}
+
+func isTextField(field patternField) bool {
+	return field.letter == 'E' || field.letter == 'a' || (field.letter == 'M' || field.letter == 'L') && field.width >= 3
+}
+
+func patternFieldString(field patternField) string {
+	return strings.Repeat(string(field.letter), field.width)
+}
+
+// matchName returns index and length of the longest name which value starts with, ignoring case
+func matchName(value string, names []string) (int, int) {
+	index, length := -1, 0
+	for i, name := range names {
+		if len(name) > length && len(value) >= len(name) && strings.EqualFold(value[:len(name)], name) {
+			index, length = i, len(name)
+		}
+	}
+	return index, length
+}
+
+func writePaddedNumber(sb *strings.Builder, number, width int) {
+	if number < 0 {
+		sb.WriteByte('-')
+		number = -number
+	}
+	digits := strconv.Itoa(number)
+	for i := len(digits); i < width; i++ {
+		sb.WriteByte('0')
+	}
+	sb.WriteString(digits)
+}
+
+func pow10(exponent int) int {
+	result := 1
+	for i := 0; i < exponent; i++ {
+		result *= 10
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatterFormatsLocalTypes(t *testing.T) {
+	dateTime := NewLocalDateTime(time.Date(2018, 1, 2, 15, 4, 5, 120000000, time.UTC))
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{"02.01.2006", "02.01.2006"},
+		{"dd.MM.yyyy", "02.01.2018"},
+		{"d MMMM yyyy", "2 January 2018"},
+		{"EEE, d MMM yy", "Tue, 2 Jan 18"},
+		{"yyyyMMdd", "20180102"},
+		{"yyyy-MM-dd'T'HH:mm:ss.SSS", "2018-01-02T15:04:05.120"},
+		{"h:mm a", "3:04 PM"},
+		{"'o''clock' H", "o'clock 15"},
+	}
+	for _, test := range tests {
+		formatted, err := MustNewFormatter(test.pattern).FormatDateTime(dateTime)
+		assert.NoError(t, err, test.pattern)
+		assert.Equal(t, test.expected, formatted, test.pattern)
+	}
+
+	formatted, err := MustNewFormatter("EEEE d.M.y").FormatDate(MustParseLocalDate("2018-03-04"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Sunday 4.3.2018", formatted)
+	formatted, err = MustNewFormatter("HH:mm:ss").FormatTime(MustParseLocalTime("07:30"))
+	assert.NoError(t, err)
+	assert.Equal(t, "07:30:00", formatted)
+	formatted, err = MustNewFormatter("EEEE").FormatWeekday(Friday)
+	assert.NoError(t, err)
+	assert.Equal(t, "Friday", formatted)
+
+	_, err = MustNewFormatter("yyyy-MM-dd HH:mm").FormatDate(MustParseLocalDate("2018-03-04"))
+	assert.EqualError(t, err, `Pattern "yyyy-MM-dd HH:mm" field "HH" is not supported by LocalDate`)
+	_, err = MustNewFormatter("dd.MM").FormatTime(MustParseLocalTime("07:30"))
+	assert.Error(t, err)
+}
+
+func TestFormatterParsesLocalTypes(t *testing.T) {
+	date, err := MustNewFormatter("dd.MM.yyyy").ParseDate("02.01.2018")
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseLocalDate("2018-01-02"), date)
+	date, err = MustNewFormatter("yyyyMMdd").ParseDate("20180102")
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseLocalDate("2018-01-02"), date)
+	date, err = MustNewFormatter("EEEE, d MMMM yy").ParseDate("tuesday, 2 JANUARY 18")
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseLocalDate("2018-01-02"), date)
+
+	localTime, err := MustNewFormatter("hh:mm a").ParseTime("12:15 AM")
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseLocalTime("00:15"), localTime)
+	localTime, err = MustNewFormatter("HH:mm:ss").ParseTime("23:59:00")
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseLocalTime("23:59"), localTime)
+
+	dateTime, err := MustNewFormatter("yyyy-MM-dd'T'HH:mm:ss.SSS").ParseDateTime("2018-01-02T15:04:05.120")
+	assert.NoError(t, err)
+	assert.Equal(t, NewLocalDateTime(time.Date(2018, 1, 2, 15, 4, 5, 120000000, time.UTC)), dateTime)
+
+	weekday, err := MustNewFormatter("EEE").ParseWeekday("Wed")
+	assert.NoError(t, err)
+	assert.Equal(t, Wednesday, weekday)
+}
+
+func TestFormatterRejectsMismatchedValues(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		message string
+	}{
+		{"dd.MM.yyyy", "02-01-2018", `Cannot parse "02-01-2018" as "dd.MM.yyyy" at position 2: expected "."`},
+		{"dd.MM.yyyy", "2.01.2018", `Cannot parse "2.01.2018" as "dd.MM.yyyy" at position 0: expected 2 digit(s) of "dd"`},
+		{"dd.MM.yyyy", "02.13.2018", `Cannot parse "02.13.2018" as "dd.MM.yyyy" at position 3: "MM" must be between 1 and 12, was 13`},
+		{"dd.MM.yyyy", "30.02.2018", `Cannot parse "30.02.2018" as "dd.MM.yyyy": day 30 is out of range for February 2018`},
+		{"dd.MM.yyyy", "02.01.2018 ", `Cannot parse "02.01.2018 " as "dd.MM.yyyy" at position 10: unexpected text " "`},
+		{"EEE dd.MM.yyyy", "Mon 02.01.2018", `Cannot parse "Mon 02.01.2018" as "EEE dd.MM.yyyy": 2018-01-02 is tuesday, not monday`},
+		{"d MMM yyyy", "2 Foo 2018", `Cannot parse "2 Foo 2018" as "d MMM yyyy" at position 2: expected month name`},
+	}
+	for _, test := range tests {
+		_, err := MustNewFormatter(test.pattern).ParseDate(test.value)
+		assert.EqualError(t, err, test.message)
+		assert.IsType(t, &PatternParseError{}, err)
+	}
+
+	_, err := MustNewFormatter("HH:mm:ss").ParseTime("10:00:01")
+	assert.EqualError(t, err, `Cannot parse "10:00:01" as "HH:mm:ss": LocalTime can't have seconds`)
+	_, err = MustNewFormatter("HH:mm").ParseDate("10:00")
+	assert.EqualError(t, err, `Pattern "HH:mm" field "HH" is not supported by LocalDate`)
+	_, err = MustNewFormatter("yyyy-MM-dd").ParseDateTime("2018-01-02")
+	assert.EqualError(t, err, `Pattern "yyyy-MM-dd" doesn't contain hour`)
+	_, err = MustNewFormatter("h:mm").ParseTime("3:04")
+	assert.Error(t, err)
+}
+
+func TestNewFormatter(t *testing.T) {
+	formatter, err := NewFormatter("dd.MM.yyyy")
+	assert.NoError(t, err)
+	assert.Equal(t, "dd.MM.yyyy", formatter.Pattern())
+	assert.Same(t, formatter, MustNewFormatter("dd.MM.yyyy"))
+
+	_, err = NewFormatter("yyyy-MM-dd 'T")
+	assert.EqualError(t, err, `Wrong pattern "yyyy-MM-dd 'T": unterminated quote at position 11`)
+	_, err = NewFormatter("yyyy-MM-dd Q")
+	assert.EqualError(t, err, `Wrong pattern "yyyy-MM-dd Q": unsupported field "Q" at position 11`)
+	_, err = NewFormatter("MMMMM")
+	assert.Error(t, err)
+}
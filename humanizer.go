@@ -0,0 +1,314 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HumanUnit is a unit of time used by Humanizer
+type HumanUnit int
+
+// Units of time used by Humanizer. Months and years are used only for relative dates.
+const (
+	UnitSecond HumanUnit = iota
+	UnitMinute
+	UnitHour
+	UnitDay
+	UnitMonth
+	UnitYear
+	humanUnitCount
+)
+
+// humanUnitDurations are lengths of units used for durations
+var humanUnitDurations = [...]Duration{Second, Minute, Hour, 24 * Hour}
+
+// RelativeTexts are words used by Humanizer in a language
+type RelativeTexts struct {
+	// PluralForm returns index of unit name form for count, e.g. 0 for "1 dzień", 1 for "2 dni", 2 for "5 dni"
+	PluralForm func(count int64) int
+	// UnitNames are names of units in plural forms chosen by PluralForm, indexed by HumanUnit
+	UnitNames [humanUnitCount][]string
+	// RelativeUnitNames are names used in Future and Past phrases, when they differ from UnitNames,
+	// e.g. accusative "za 1 minutę" in Polish
+	RelativeUnitNames [humanUnitCount][]string
+	// Future and Past are fmt patterns of relative phrases, e.g. "in %v" and "%v ago"
+	Future string
+	Past   string
+	// Now is used for date-time which differs from now by less than a minute
+	Now       string
+	Today     string
+	Yesterday string
+	Tomorrow  string
+	// AtTime is fmt pattern joining day and time, e.g. "%v at %v"
+	AtTime string
+}
+
+// EnglishPluralForm returns 0 for one and 1 for other counts
+func EnglishPluralForm(count int64) int {
+	if count == 1 {
+		return 0
+	}
+	return 1
+}
+
+// PolishPluralForm returns 0 for one, 1 for few (2-4, 22-24, ...) and 2 for many counts
+func PolishPluralForm(count int64) int {
+	if count < 0 {
+		count = -count
+	}
+	switch {
+	case count == 1:
+		return 0
+	case count%10 >= 2 && count%10 <= 4 && (count%100 < 12 || count%100 > 14):
+		return 1
+	}
+	return 2
+}
+
+var englishRelativeTexts = RelativeTexts{
+	PluralForm: EnglishPluralForm,
+	UnitNames: [humanUnitCount][]string{
+		UnitSecond: {"second", "seconds"},
+		UnitMinute: {"minute", "minutes"},
+		UnitHour:   {"hour", "hours"},
+		UnitDay:    {"day", "days"},
+		UnitMonth:  {"month", "months"},
+		UnitYear:   {"year", "years"},
+	},
+	Future:    "in %v",
+	Past:      "%v ago",
+	Now:       "just now",
+	Today:     "today",
+	Yesterday: "yesterday",
+	Tomorrow:  "tomorrow",
+	AtTime:    "%v at %v",
+}
+
+var polishRelativeTexts = RelativeTexts{
+	PluralForm: PolishPluralForm,
+	UnitNames: [humanUnitCount][]string{
+		UnitSecond: {"sekunda", "sekundy", "sekund"},
+		UnitMinute: {"minuta", "minuty", "minut"},
+		UnitHour:   {"godzina", "godziny", "godzin"},
+		UnitDay:    {"dzień", "dni", "dni"},
+		UnitMonth:  {"miesiąc", "miesiące", "miesięcy"},
+		UnitYear:   {"rok", "lata", "lat"},
+	},
+	RelativeUnitNames: [humanUnitCount][]string{
+		UnitSecond: {"sekundę", "sekundy", "sekund"},
+		UnitMinute: {"minutę", "minuty", "minut"},
+		UnitHour:   {"godzinę", "godziny", "godzin"},
+	},
+	Future:    "za %v",
+	Past:      "%v temu",
+	Now:       "teraz",
+	Today:     "dzisiaj",
+	Yesterday: "wczoraj",
+	Tomorrow:  "jutro",
+	AtTime:    "%v o %v",
+}
+
+// clone copies texts together with their unit names
+func (rt RelativeTexts) clone() RelativeTexts {
+	for unit := range rt.UnitNames {
+		rt.UnitNames[unit] = slices.Clone(rt.UnitNames[unit])
+		rt.RelativeUnitNames[unit] = slices.Clone(rt.RelativeUnitNames[unit])
+	}
+	return rt
+}
+
+// Humanizer renders dates, date-times and durations in words, e.g. "za 3 dni", "2 hours ago"
+// or "yesterday at 14:00". Relative values are computed against current time of the clock.
+type Humanizer struct {
+	clock     Clock
+	locale    *Locale
+	precision HumanUnit
+	maxUnits  int
+}
+
+// NewHumanizer creates Humanizer using English locale, which renders durations
+// with precision of a second in at most two units, e.g. "2 hours 30 minutes"
+func NewHumanizer(clock Clock) *Humanizer {
+	return &Humanizer{clock: clock, precision: UnitSecond, maxUnits: 2}
+}
+
+// WithLocale returns humanizer using words from given locale
+func (h *Humanizer) WithLocale(locale *Locale) *Humanizer {
+	humanizer := *h
+	humanizer.locale = locale
+	return &humanizer
+}
+
+// WithPrecision returns humanizer which doesn't render units smaller than precision.
+// Durations are rounded to the precision, relative date-times closer than one precision unit
+// to now are rendered as now, and precision of a day or more makes date-times rendered as dates.
+func (h *Humanizer) WithPrecision(precision HumanUnit) *Humanizer {
+	humanizer := *h
+	humanizer.precision = precision
+	return &humanizer
+}
+
+// WithMaxUnits returns humanizer which renders durations in at most maxUnits consecutive units,
+// rounding the rest, e.g. "1 day 3 hours" rather than "1 day 3 hours 4 minutes" for two units
+func (h *Humanizer) WithMaxUnits(maxUnits int) *Humanizer {
+	humanizer := *h
+	if maxUnits < 1 {
+		maxUnits = 1
+	}
+	humanizer.maxUnits = maxUnits
+	return &humanizer
+}
+
+func (h *Humanizer) texts() *RelativeTexts {
+	locale := h.locale
+	if locale == nil || locale.Relative.PluralForm == nil {
+		return &englishRelativeTexts
+	}
+	return &locale.Relative
+}
+
+// Duration renders duration in words, e.g. "2 hours 30 minutes" or "1 dzień 3 godziny".
+// Sign of the duration is ignored, the last unit is rounded half up and units equal to zero are skipped.
+func (h *Humanizer) Duration(d Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	precision := h.precision
+	if precision > UnitDay {
+		precision = UnitDay
+	}
+	largest := precision
+	for largest < UnitDay && d >= humanUnitDurations[largest+1] {
+		largest++
+	}
+	smallest := largest - HumanUnit(h.maxUnits-1)
+	if smallest < precision {
+		smallest = precision
+	}
+
+	unit := humanUnitDurations[smallest]
+	rounded := (d + unit/2) / unit * unit
+	if largest < UnitDay && rounded >= humanUnitDurations[largest+1] {
+		largest++
+	}
+
+	texts := h.texts()
+	var parts []string
+	for u := largest; u >= smallest; u-- {
+		count := int64(rounded / humanUnitDurations[u])
+		rounded -= Duration(count) * humanUnitDurations[u]
+		if count != 0 || len(parts) == 0 && u == smallest {
+			parts = append(parts, texts.count(count, u, false))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// DateTime renders date-time relative to now:
+// less than a minute from now as now, less than 45 minutes in minutes,
+// in hours when it is less than 6 hours or on the same day,
+// then as yesterday or tomorrow with time and as a relative date otherwise.
+func (h *Humanizer) DateTime(ctx context.Context, ldt LocalDateTime) string {
+	now := h.clock.Now(ctx)
+	if h.precision >= UnitDay {
+		return h.relativeDate(ldt.Date(), now.Date())
+	}
+	texts := h.texts()
+	diff := ldt.Sub(now)
+	abs := diff
+	if abs < 0 {
+		abs = -abs
+	}
+	threshold := Minute
+	if h.precision > UnitMinute {
+		threshold = humanUnitDurations[h.precision]
+	}
+
+	switch {
+	case abs < threshold:
+		return texts.Now
+	case abs < 45*Minute && h.precision <= UnitMinute:
+		return texts.relative(roundDuration(abs, Minute), UnitMinute, diff > 0)
+	case abs < 6*Hour || ldt.Date() == now.Date():
+		return texts.relative(roundDuration(abs, Hour), UnitHour, diff > 0)
+	}
+	switch ldt.Date() {
+	case now.Date().AddDate(0, 0, -1):
+		return fmt.Sprintf(texts.AtTime, texts.Yesterday, ldt.Time())
+	case now.Date().Next():
+		return fmt.Sprintf(texts.AtTime, texts.Tomorrow, ldt.Time())
+	}
+	return h.relativeDate(ldt.Date(), now.Date())
+}
+
+// Date renders date relative to today, e.g. "today", "in 3 days", "2 months ago".
+// Months are counted as 30 and years as 365 days and rounded half up.
+func (h *Humanizer) Date(ctx context.Context, d LocalDate) string {
+	return h.relativeDate(d, h.clock.Today(ctx))
+}
+
+func (h *Humanizer) relativeDate(d, today LocalDate) string {
+	texts := h.texts()
+	days := int64(d.GetStartOfDayUTC().Sub(today.GetStartOfDayUTC()) / (24 * time.Hour))
+	abs := days
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case days == 0:
+		return texts.Today
+	case days == -1:
+		return texts.Yesterday
+	case days == 1:
+		return texts.Tomorrow
+	case abs < 30:
+		return texts.relative(abs, UnitDay, days > 0)
+	case abs < 365:
+		return texts.relative(minInt64(roundDiv(abs, 30), 11), UnitMonth, days > 0)
+	}
+	return texts.relative(roundDiv(abs, 365), UnitYear, days > 0)
+}
+
+// relative renders count of units as future or past phrase
+func (t *RelativeTexts) relative(count int64, unit HumanUnit, future bool) string {
+	if future {
+		return fmt.Sprintf(t.Future, t.count(count, unit, true))
+	}
+	return fmt.Sprintf(t.Past, t.count(count, unit, true))
+}
+
+// count renders count with unit name in proper plural form, e.g. "5 dni"
+func (t *RelativeTexts) count(count int64, unit HumanUnit, relative bool) string {
+	names := t.UnitNames[unit]
+	if relative && t.RelativeUnitNames[unit] != nil {
+		names = t.RelativeUnitNames[unit]
+	}
+	form := t.PluralForm(count)
+	if form >= len(names) {
+		form = len(names) - 1
+	}
+	return strconv.FormatInt(count, 10) + " " + names[form]
+}
+
+// roundDuration returns number of units in d rounded half up, at least 1
+func roundDuration(d, unit Duration) int64 {
+	if count := int64((d + unit/2) / unit); count > 0 {
+		return count
+	}
+	return 1
+}
+
+func roundDiv(x, y int64) int64 {
+	return (x + y/2) / y
+}
+
+func minInt64(x, y int64) int64 {
+	if x < y {
+		return x
+	}
+	return y
+}
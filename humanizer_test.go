@@ -0,0 +1,108 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// contextAt returns context with current time set to date-time in Warsaw
+func contextAt(t *testing.T, dateTime string) context.Context {
+	now := MustParseLocalDateTime(dateTime).GoTime(warsaw(t))
+	return context.WithValue(context.Background(), CurrentTimeKey, &now)
+}
+
+func TestHumanizerDuration(t *testing.T) {
+	humanizer := NewHumanizer(NewClock())
+	polish := humanizer.WithLocale(PolishLocale())
+	tests := []struct {
+		duration Duration
+		english  string
+		polish   string
+	}{
+		{0, "0 seconds", "0 sekund"},
+		{Second, "1 second", "1 sekunda"},
+		{22 * Second, "22 seconds", "22 sekundy"},
+		{2*Hour + 30*Minute + 20*Second, "2 hours 30 minutes", "2 godziny 30 minut"},
+		{2*Hour + 30*Minute + 40*Second, "2 hours 31 minutes", "2 godziny 31 minut"},
+		{-(24*Hour + 5*Minute), "1 day", "1 dzień"},
+		{5*24*Hour + 12*Hour, "5 days 12 hours", "5 dni 12 godzin"},
+		{59*Minute + 59*Second + 600*Millisecond, "1 hour", "1 godzina"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.english, humanizer.Duration(test.duration))
+		assert.Equal(t, test.polish, polish.Duration(test.duration))
+	}
+
+	assert.Equal(t, "2 days 3 hours 4 minutes", humanizer.WithMaxUnits(3).Duration(51*Hour+4*Minute+5*Second))
+	assert.Equal(t, "2 days", humanizer.WithMaxUnits(1).Duration(51*Hour))
+	assert.Equal(t, "3 hours", humanizer.WithPrecision(UnitHour).Duration(2*Hour+30*Minute))
+	assert.Equal(t, "0 minutes", humanizer.WithPrecision(UnitMinute).Duration(29*Second))
+}
+
+func TestHumanizerDateTime(t *testing.T) {
+	ctx := contextAt(t, "2018-03-14 15:00")
+	humanizer := NewHumanizer(NewClock())
+	polish := humanizer.WithLocale(PolishLocale())
+	tests := []struct {
+		dateTime string
+		english  string
+		polish   string
+	}{
+		{"2018-03-14 15:00:30", "just now", "teraz"},
+		{"2018-03-14 15:01", "in 1 minute", "za 1 minutę"},
+		{"2018-03-14 14:55", "5 minutes ago", "5 minut temu"},
+		{"2018-03-14 13:00", "2 hours ago", "2 godziny temu"},
+		{"2018-03-14 22:00", "in 7 hours", "za 7 godzin"},
+		{"2018-03-13 14:00", "yesterday at 14:00", "wczoraj o 14:00"},
+		{"2018-03-15 08:30", "tomorrow at 08:30", "jutro o 08:30"},
+		{"2018-03-17 10:00", "in 3 days", "za 3 dni"},
+		{"2018-03-09 10:00", "5 days ago", "5 dni temu"},
+	}
+	for _, test := range tests {
+		dateTime := MustParseLocalDateTime(test.dateTime)
+		assert.Equal(t, test.english, humanizer.DateTime(ctx, dateTime), test.dateTime)
+		assert.Equal(t, test.polish, polish.DateTime(ctx, dateTime), test.dateTime)
+	}
+
+	assert.Equal(t, "just now", humanizer.WithPrecision(UnitHour).DateTime(ctx, MustParseLocalDateTime("2018-03-14 14:20")))
+	assert.Equal(t, "today", humanizer.WithPrecision(UnitDay).DateTime(ctx, MustParseLocalDateTime("2018-03-14 10:00")))
+}
+
+func TestHumanizerDate(t *testing.T) {
+	ctx := contextAt(t, "2018-03-14 00:30")
+	polish := NewHumanizer(NewClock()).WithLocale(PolishLocale())
+	tests := []struct {
+		date     string
+		expected string
+	}{
+		{"2018-03-14", "dzisiaj"},
+		{"2018-03-13", "wczoraj"},
+		{"2018-03-15", "jutro"},
+		{"2018-03-16", "za 2 dni"},
+		{"2018-04-20", "za 1 miesiąc"},
+		{"2018-01-01", "2 miesiące temu"},
+		{"2017-06-01", "10 miesięcy temu"},
+		{"2021-03-01", "za 3 lata"},
+		{"2013-03-01", "5 lat temu"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, polish.Date(ctx, MustParseLocalDate(test.date)), test.date)
+	}
+}
+
+func TestPolishPluralForm(t *testing.T) {
+	names := []string{"dzień", "dni", "dni"}
+	for count, expected := range map[int64]int{0: 2, 1: 0, 2: 1, 4: 1, 5: 2, 12: 2, 14: 2, 21: 2, 22: 1, 112: 2, 124: 1} {
+		assert.Equal(t, expected, PolishPluralForm(count), names[expected])
+	}
+	assert.Equal(t, 1, EnglishPluralForm(0))
+	assert.Equal(t, 0, EnglishPluralForm(1))
+}
+
+func TestHumanizerFallsBackToEnglishTexts(t *testing.T) {
+	custom := *PolishLocale()
+	custom.Relative = RelativeTexts{}
+	assert.Equal(t, "3 days", NewHumanizer(NewClock()).WithLocale(&custom).Duration(72*Hour))
+}
@@ -0,0 +1,409 @@
+package time
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+const nanosPerSecond = int64(time.Second)
+
+// Instant represents a point on the time-line with nanosecond precision,
+// stored as seconds elapsed since January 1, 1970 UTC and nanoseconds within the second.
+type Instant struct {
+	seconds int64
+	nanos   int32
+}
+
+// NewInstant creates Instant from seconds and nanoseconds elapsed since January 1, 1970 UTC.
+// Nanoseconds outside of [0, 999999999] are normalized.
+func NewInstant(seconds int64, nanos int64) Instant {
+	seconds += floorDiv(nanos, nanosPerSecond)
+	return Instant{seconds: seconds, nanos: int32(floorMod(nanos, nanosPerSecond))}
+}
+
+// ToInstant converts go's time.Time to Instant
+func ToInstant(t time.Time) Instant {
+	return NewInstant(t.Unix(), int64(t.Nanosecond()))
+}
+
+// InstantFromUnixMilli creates Instant from milliseconds elapsed since January 1, 1970 UTC
+func InstantFromUnixMilli(milliseconds int64) Instant {
+	return NewInstant(floorDiv(milliseconds, 1e3), floorMod(milliseconds, 1e3)*int64(time.Millisecond))
+}
+
+// InstantFromUnixMicro creates Instant from microseconds elapsed since January 1, 1970 UTC
+func InstantFromUnixMicro(microseconds int64) Instant {
+	return NewInstant(floorDiv(microseconds, 1e6), floorMod(microseconds, 1e6)*int64(time.Microsecond))
+}
+
+// InstantFromUnixNano creates Instant from nanoseconds elapsed since January 1, 1970 UTC
+func InstantFromUnixNano(nanoseconds int64) Instant {
+	return NewInstant(0, nanoseconds)
+}
+
+// InstantAt returns instant of date-time in given time zone, see AtZone
+func (ldt LocalDateTime) InstantAt(location *time.Location, resolver ZoneResolver) (Instant, error) {
+	zdt, err := ldt.AtZone(location, resolver)
+	if err != nil {
+		return Instant{}, err
+	}
+	return zdt.Instant(), nil
+}
+
+// Instant returns instant denoted by date-time
+func (zdt ZonedDateTime) Instant() Instant {
+	return ToInstant(zdt.GoTime())
+}
+
+// Instant returns instant denoted by date-time
+func (odt OffsetDateTime) Instant() Instant {
+	return ToInstant(odt.GoTime())
+}
+
+// ParseInstant parses RFC 3339 date-time with offset, e.g. "2018-01-02T15:04:05.123Z", into Instant
+func ParseInstant(value string) (Instant, error) {
+	odt, err := ParseOffsetDateTime(value)
+	if err != nil {
+		return Instant{}, err
+	}
+	return odt.Instant(), nil
+}
+
+// MustParseInstant is like ParseInstant but panics on error
+func MustParseInstant(value string) Instant {
+	instant, err := ParseInstant(value)
+	if err != nil {
+		panic(err)
+	}
+	return instant
+}
+
+// Seconds returns seconds elapsed since January 1, 1970 UTC
+func (i Instant) Seconds() int64 {
+	return i.seconds
+}
+
+// Nanos returns nanoseconds within the second, in range [0, 999999999]
+func (i Instant) Nanos() int {
+	return int(i.nanos)
+}
+
+// UnixTimeStamp converts instant to UnixTimeStamp truncating fraction of a second
+func (i Instant) UnixTimeStamp() UnixTimeStamp {
+	return UnixTimeStamp(i.seconds)
+}
+
+// UnixMilli returns milliseconds elapsed since January 1, 1970 UTC, truncating the rest
+func (i Instant) UnixMilli() int64 {
+	return i.seconds*1e3 + int64(i.nanos)/int64(time.Millisecond)
+}
+
+// UnixMicro returns microseconds elapsed since January 1, 1970 UTC, truncating the rest
+func (i Instant) UnixMicro() int64 {
+	return i.seconds*1e6 + int64(i.nanos)/int64(time.Microsecond)
+}
+
+// UnixNano returns nanoseconds elapsed since January 1, 1970 UTC.
+// The result is undefined if it doesn't fit in int64 (dates before 1678 or after 2262).
+func (i Instant) UnixNano() int64 {
+	return i.seconds*nanosPerSecond + int64(i.nanos)
+}
+
+// GoTime converts instant to time.Time in UTC
+func (i Instant) GoTime() time.Time {
+	return time.Unix(i.seconds, int64(i.nanos)).UTC()
+}
+
+// LocalDateTime returns date-time of the instant in given time zone
+func (i Instant) LocalDateTime(location *time.Location) LocalDateTime {
+	return NewLocalDateTime(i.GoTime().In(location))
+}
+
+// AtZone returns date-time of the instant in given time zone
+func (i Instant) AtZone(location *time.Location) ZonedDateTime {
+	return ToZonedDateTime(i.GoTime().In(location))
+}
+
+// Add returns the instant i+d.
+func (i Instant) Add(d Duration) Instant {
+	return NewInstant(i.seconds, int64(i.nanos)+int64(d))
+}
+
+// Sub returns the duration i-u, see LocalDateTime.Sub
+func (i Instant) Sub(u Instant) Duration {
+	return Duration(i.GoTime().Sub(u.GoTime()))
+}
+
+// Before reports whether instant i is before u.
+func (i Instant) Before(u Instant) bool {
+	return i.seconds < u.seconds || i.seconds == u.seconds && i.nanos < u.nanos
+}
+
+// After reports whether instant i is after u.
+func (i Instant) After(u Instant) bool {
+	return u.Before(i)
+}
+
+// Equal reports whether instant i is equal to u.
+func (i Instant) Equal(u Instant) bool {
+	return i == u
+}
+
+// String formats instant in RFC 3339 in UTC, e.g. "2018-01-02T15:04:05.123Z"
+func (i Instant) String() string {
+	return i.GoTime().Format(time.RFC3339Nano)
+}
+
+// MarshalText serializes instant to RFC 3339 string
+func (i Instant) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText parses RFC 3339 string into instant
+func (i *Instant) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	instant, err := ParseInstant(string(text))
+	if err != nil {
+		return err
+	}
+	*i = instant
+	return nil
+}
+
+// MarshalJSON marshals instant to JSON string in RFC 3339.
+// Convert it to UnixTimeStamp, UnixMilliTimeStamp, UnixMicroTimeStamp
+// or UnixNanoTimeStamp to marshal it as a number.
+func (i Instant) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON parses JSON string in RFC 3339 or JSON number of seconds
+// elapsed since January 1, 1970 UTC (with optional fraction) into instant
+func (i *Instant) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	i.unmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (i *Instant) unmarshalEasyJSON(in *jlexer.Lexer) {
+	if in.CurrentToken() == jlexer.TokenNumber {
+		if number := in.JsonNumber(); in.Ok() {
+			instant, err := parseUnixSeconds(number.String())
+			if err != nil {
+				in.AddError(err)
+				return
+			}
+			*i = instant
+		}
+		return
+	}
+	if data := in.String(); in.Ok() {
+		instant, err := ParseInstant(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*i = instant
+	}
+}
+
+// parseUnixSeconds parses decimal number of seconds, e.g. "1514905445.123", into instant
+func parseUnixSeconds(value string) (Instant, error) {
+	whole, fraction, _ := strings.Cut(value, ".")
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return Instant{}, err
+	}
+	if fraction == "" {
+		return NewInstant(seconds, 0), nil
+	}
+	if len(fraction) > 9 {
+		fraction = fraction[:9]
+	}
+	nanos, err := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+	if err != nil {
+		return Instant{}, err
+	}
+	if strings.HasPrefix(whole, "-") {
+		nanos = -nanos
+	}
+	return NewInstant(seconds, nanos), nil
+}
+
+// Scan implements the Scanner interface.
+func (i *Instant) Scan(value interface{}) error {
+	if t, ok := value.(time.Time); ok {
+		*i = ToInstant(t)
+		return nil
+	}
+	text, err := scanText("Instant", value)
+	if err != nil {
+		return err
+	}
+	instant, err := ParseInstant(text)
+	if err != nil {
+		return fmt.Errorf("sql: failed to scan into Instant: %v", err)
+	}
+	*i = instant
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+func (i Instant) Value() (driver.Value, error) {
+	return i.GoTime(), nil
+}
+
+func floorDiv(x, y int64) int64 {
+	q := x / y
+	if x%y != 0 && (x < 0) != (y < 0) {
+		q--
+	}
+	return q
+}
+
+func floorMod(x, y int64) int64 {
+	return x - floorDiv(x, y)*y
+}
+
+// UnixMilliTimeStamp represents number of milliseconds
+// elapsed since January 1, 1970 UTC.
+type UnixMilliTimeStamp int64
+
+// Instant converts timestamp to Instant
+func (uts UnixMilliTimeStamp) Instant() Instant {
+	return InstantFromUnixMilli(int64(uts))
+}
+
+// UnixMilliTimeStamp converts instant to UnixMilliTimeStamp
+func (i Instant) UnixMilliTimeStamp() UnixMilliTimeStamp {
+	return UnixMilliTimeStamp(i.UnixMilli())
+}
+
+// Value implements the sql driver Valuer interface.
+func (uts UnixMilliTimeStamp) Value() (driver.Value, error) {
+	return int64(uts), nil
+}
+
+// Scan implements the Scanner interface.
+// It accepts integer values as well as their textual form.
+func (uts *UnixMilliTimeStamp) Scan(value interface{}) error {
+	return scanUnixTimestamp("UnixMilliTimeStamp", value, time.Millisecond, (*int64)(uts))
+}
+
+// MarshalJSON marshals timestamp to JSON number
+func (uts UnixMilliTimeStamp) MarshalJSON() ([]byte, error) {
+	return unixTimestampText(int64(uts)), nil
+}
+
+// UnmarshalJSON parses JSON number, or a string containing a number, into timestamp
+func (uts *UnixMilliTimeStamp) UnmarshalJSON(data []byte) error {
+	return unmarshalUnixTimestampJSON(data, (*int64)(uts))
+}
+
+// MarshalText serializes timestamp to decimal string
+func (uts UnixMilliTimeStamp) MarshalText() ([]byte, error) {
+	return unixTimestampText(int64(uts)), nil
+}
+
+// UnmarshalText parses decimal string into timestamp
+func (uts *UnixMilliTimeStamp) UnmarshalText(text []byte) error {
+	return parseUnixTimestamp(text, (*int64)(uts))
+}
+
+// UnixMicroTimeStamp represents number of microseconds
+// elapsed since January 1, 1970 UTC.
+type UnixMicroTimeStamp int64
+
+// Instant converts timestamp to Instant
+func (uts UnixMicroTimeStamp) Instant() Instant {
+	return InstantFromUnixMicro(int64(uts))
+}
+
+// UnixMicroTimeStamp converts instant to UnixMicroTimeStamp
+func (i Instant) UnixMicroTimeStamp() UnixMicroTimeStamp {
+	return UnixMicroTimeStamp(i.UnixMicro())
+}
+
+// Value implements the sql driver Valuer interface.
+func (uts UnixMicroTimeStamp) Value() (driver.Value, error) {
+	return int64(uts), nil
+}
+
+// Scan implements the Scanner interface.
+// It accepts integer values as well as their textual form.
+func (uts *UnixMicroTimeStamp) Scan(value interface{}) error {
+	return scanUnixTimestamp("UnixMicroTimeStamp", value, time.Microsecond, (*int64)(uts))
+}
+
+// MarshalJSON marshals timestamp to JSON number
+func (uts UnixMicroTimeStamp) MarshalJSON() ([]byte, error) {
+	return unixTimestampText(int64(uts)), nil
+}
+
+// UnmarshalJSON parses JSON number, or a string containing a number, into timestamp
+func (uts *UnixMicroTimeStamp) UnmarshalJSON(data []byte) error {
+	return unmarshalUnixTimestampJSON(data, (*int64)(uts))
+}
+
+// MarshalText serializes timestamp to decimal string
+func (uts UnixMicroTimeStamp) MarshalText() ([]byte, error) {
+	return unixTimestampText(int64(uts)), nil
+}
+
+// UnmarshalText parses decimal string into timestamp
+func (uts *UnixMicroTimeStamp) UnmarshalText(text []byte) error {
+	return parseUnixTimestamp(text, (*int64)(uts))
+}
+
+// UnixNanoTimeStamp represents number of nanoseconds
+// elapsed since January 1, 1970 UTC.
+type UnixNanoTimeStamp int64
+
+// Instant converts timestamp to Instant
+func (uts UnixNanoTimeStamp) Instant() Instant {
+	return InstantFromUnixNano(int64(uts))
+}
+
+// UnixNanoTimeStamp converts instant to UnixNanoTimeStamp
+func (i Instant) UnixNanoTimeStamp() UnixNanoTimeStamp {
+	return UnixNanoTimeStamp(i.UnixNano())
+}
+
+// Value implements the sql driver Valuer interface.
+func (uts UnixNanoTimeStamp) Value() (driver.Value, error) {
+	return int64(uts), nil
+}
+
+// Scan implements the Scanner interface.
+// It accepts integer values as well as their textual form.
+func (uts *UnixNanoTimeStamp) Scan(value interface{}) error {
+	return scanUnixTimestamp("UnixNanoTimeStamp", value, time.Nanosecond, (*int64)(uts))
+}
+
+// MarshalJSON marshals timestamp to JSON number
+func (uts UnixNanoTimeStamp) MarshalJSON() ([]byte, error) {
+	return unixTimestampText(int64(uts)), nil
+}
+
+// UnmarshalJSON parses JSON number, or a string containing a number, into timestamp
+func (uts *UnixNanoTimeStamp) UnmarshalJSON(data []byte) error {
+	return unmarshalUnixTimestampJSON(data, (*int64)(uts))
+}
+
+// MarshalText serializes timestamp to decimal string
+func (uts UnixNanoTimeStamp) MarshalText() ([]byte, error) {
+	return unixTimestampText(int64(uts)), nil
+}
+
+// UnmarshalText parses decimal string into timestamp
+func (uts *UnixNanoTimeStamp) UnmarshalText(text []byte) error {
+	return parseUnixTimestamp(text, (*int64)(uts))
+}
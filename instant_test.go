@@ -0,0 +1,117 @@
+package time
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInstantNormalizesNanos(t *testing.T) {
+	assert.Equal(t, NewInstant(11, 500), NewInstant(10, int64(time.Second)+500))
+	instant := NewInstant(10, -1)
+	assert.Equal(t, int64(9), instant.Seconds())
+	assert.Equal(t, 999999999, instant.Nanos())
+}
+
+func TestInstantUnixConversions(t *testing.T) {
+	instant := ToInstant(time.Date(2018, 1, 2, 15, 4, 5, 123456789, time.UTC))
+	assert.Equal(t, UnixTimeStamp(1514905445), instant.UnixTimeStamp())
+	assert.Equal(t, int64(1514905445123), instant.UnixMilli())
+	assert.Equal(t, int64(1514905445123456), instant.UnixMicro())
+	assert.Equal(t, int64(1514905445123456789), instant.UnixNano())
+
+	assert.Equal(t, NewInstant(1514905445, 123000000), InstantFromUnixMilli(1514905445123))
+	assert.Equal(t, NewInstant(1514905445, 123456000), InstantFromUnixMicro(1514905445123456))
+	assert.Equal(t, instant, InstantFromUnixNano(1514905445123456789))
+	assert.Equal(t, NewInstant(1514905445, 0), UnixTimeStamp(1514905445).Instant())
+	assert.Equal(t, UnixMilliTimeStamp(1514905445123), instant.UnixMilliTimeStamp())
+	assert.Equal(t, NewInstant(1514905445, 123000000), UnixMilliTimeStamp(1514905445123).Instant())
+
+	beforeEpoch := InstantFromUnixMilli(-1500)
+	assert.Equal(t, NewInstant(-2, 500000000), beforeEpoch)
+	assert.Equal(t, int64(-1500), beforeEpoch.UnixMilli())
+	assert.True(t, time.UnixMilli(-1500).Equal(beforeEpoch.GoTime()))
+}
+
+func TestInstantTimeZoneConversions(t *testing.T) {
+	instant := MustParseInstant("2018-07-02T13:04:05.5Z")
+	assert.Equal(t, MustParseLocalDateTime("2018-07-02 15:04").Add(5500*Millisecond), instant.LocalDateTime(warsaw(t)))
+	assert.Equal(t, "2018-07-02T15:04:05.5+02:00[Europe/Warsaw]", instant.AtZone(warsaw(t)).String())
+
+	fromLocal, err := MustParseLocalDateTime("2018-07-02 15:04").Add(5500*Millisecond).InstantAt(warsaw(t), ResolveStrict)
+	assert.NoError(t, err)
+	assert.Equal(t, instant, fromLocal)
+	assert.Equal(t, instant, MustParseOffsetDateTime("2018-07-02T14:04:05.5+01:00").Instant())
+
+	_, err = MustParseLocalDateTime("2018-03-25 02:30").InstantAt(warsaw(t), ResolveStrict)
+	assert.Equal(t, ErrSkippedLocalDateTime, err)
+}
+
+func TestInstantArithmetic(t *testing.T) {
+	instant := MustParseInstant("2018-01-02T15:04:05.9Z")
+	later := instant.Add(200 * Millisecond)
+	assert.Equal(t, "2018-01-02T15:04:06.1Z", later.String())
+	assert.Equal(t, 200*Millisecond, later.Sub(instant))
+	assert.True(t, instant.Before(later))
+	assert.True(t, later.After(instant))
+	assert.True(t, instant.Equal(later.Add(-200*Millisecond)))
+}
+
+func TestInstantJSON(t *testing.T) {
+	instant := MustParseInstant("2018-01-02T15:04:05.123+01:00")
+	JSON, err := json.Marshal(instant)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2018-01-02T14:04:05.123Z"`, string(JSON))
+
+	var actual Instant
+	assert.NoError(t, json.Unmarshal(JSON, &actual))
+	assert.Equal(t, instant, actual)
+	assert.NoError(t, json.Unmarshal([]byte(`1514901845.123`), &actual))
+	assert.Equal(t, instant, actual)
+	assert.NoError(t, json.Unmarshal([]byte(`-0.5`), &actual))
+	assert.Equal(t, NewInstant(-1, 500000000), actual)
+	assert.Error(t, json.Unmarshal([]byte(`"2018-01-02"`), &actual))
+	assert.Error(t, json.Unmarshal([]byte(`true`), &actual))
+
+	type event struct {
+		At   UnixMilliTimeStamp `json:"at"`
+		Seen UnixTimeStamp      `json:"seen"`
+	}
+	JSON, err = json.Marshal(event{At: instant.UnixMilliTimeStamp(), Seen: instant.UnixTimeStamp()})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"at":1514901845123,"seen":1514901845}`, string(JSON))
+	var e event
+	assert.NoError(t, json.Unmarshal([]byte(`{"at":"1514901845123","seen":1514901845}`), &e))
+	assert.Equal(t, event{At: 1514901845123, Seen: 1514901845}, e)
+}
+
+func TestUnixTimeStampText(t *testing.T) {
+	text, err := UnixTimeStamp(1514905445).MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "1514905445", string(text))
+	var uts UnixTimeStamp
+	assert.NoError(t, uts.UnmarshalText([]byte("1514905445")))
+	assert.Equal(t, UnixTimeStamp(1514905445), uts)
+	assert.Error(t, uts.UnmarshalText([]byte("now")))
+
+	var milli UnixMilliTimeStamp
+	assert.NoError(t, milli.Scan([]byte("1514905445123")))
+	assert.Equal(t, UnixMilliTimeStamp(1514905445123), milli)
+	assert.NoError(t, milli.Scan(time.UnixMilli(1514905445124)))
+	assert.Equal(t, UnixMilliTimeStamp(1514905445124), milli)
+}
+
+func TestInstantScanAndValue(t *testing.T) {
+	var instant Instant
+	assert.NoError(t, instant.Scan(time.Date(2018, 1, 2, 15, 4, 5, 123456000, time.FixedZone("CET", 3600))))
+	assert.Equal(t, MustParseInstant("2018-01-02T14:04:05.123456Z"), instant)
+	assert.NoError(t, instant.Scan("2018-01-02 15:04:05.123456+01"))
+	assert.Equal(t, MustParseInstant("2018-01-02T14:04:05.123456Z"), instant)
+	assert.Error(t, instant.Scan(nil))
+
+	value, err := instant.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2018, 1, 2, 14, 4, 5, 123456000, time.UTC), value)
+}
@@ -0,0 +1,148 @@
+package time
+
+import "sync"
+
+// SpanEntry is a DateTimeSpan with a value attached in SpanIndex
+type SpanEntry[V comparable] struct {
+	Span  DateTimeSpan
+	Value V
+}
+
+// SpanIndex is an index of DateTimeSpans with attached values, answering which spans
+// overlap a span or contain a moment in O(log n + k), e.g. to find collisions of a booking.
+// Spans overlap as in DateTimeSpan.Overlaps and may repeat.
+// SpanIndex is safe for concurrent use, queries don't block each other.
+// Zero value is an empty index.
+type SpanIndex[V comparable] struct {
+	mutex sync.RWMutex
+	tree  intervalTree[SpanEntry[V]]
+}
+
+// NewSpanIndex creates empty SpanIndex
+func NewSpanIndex[V comparable]() *SpanIndex[V] {
+	return &SpanIndex[V]{}
+}
+
+// Insert adds span with value to the index
+func (idx *SpanIndex[V]) Insert(span DateTimeSpan, value V) {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+	idx.tree.insert(span.from, span.to, SpanEntry[V]{Span: span, Value: value})
+}
+
+// Delete removes span with value from the index, one occurrence if it was inserted many times.
+// It returns false when there is no such entry.
+func (idx *SpanIndex[V]) Delete(span DateTimeSpan, value V) bool {
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+	return idx.tree.delete(span.from, span.to, SpanEntry[V]{Span: span, Value: value})
+}
+
+// Len returns the number of entries in the index
+func (idx *SpanIndex[V]) Len() int {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+	return idx.tree.size
+}
+
+// Overlapping returns entries overlapping span, ordered by start
+func (idx *SpanIndex[V]) Overlapping(span DateTimeSpan) []SpanEntry[V] {
+	return idx.query(span.from, span.to)
+}
+
+// Containing returns entries containing given moment, ordered by start
+func (idx *SpanIndex[V]) Containing(ldt LocalDateTime) []SpanEntry[V] {
+	return idx.query(ldt, ldt.Add(Nanosecond))
+}
+
+// Stabbing returns entries having any moment on given day, ordered by start
+func (idx *SpanIndex[V]) Stabbing(date LocalDate) []SpanEntry[V] {
+	return idx.query(date.Start(), date.End())
+}
+
+func (idx *SpanIndex[V]) query(from, to LocalDateTime) []SpanEntry[V] {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+	var entries []SpanEntry[V]
+	idx.tree.overlapping(from, to, func(n *intervalNode[SpanEntry[V]]) {
+		entries = append(entries, n.entry)
+	})
+	return entries
+}
+
+// PeriodEntry is a Period with a value attached in PeriodIndex
+type PeriodEntry[V comparable] struct {
+	Period Period
+	Value  V
+}
+
+// PeriodIndex is an index of Periods with attached values, answering which periods
+// overlap a period or contain a day in O(log n + k). Periods may repeat.
+// PeriodIndex is safe for concurrent use, queries don't block each other.
+// Zero value is an empty index.
+type PeriodIndex[V comparable] struct {
+	mutex sync.RWMutex
+	tree  intervalTree[PeriodEntry[V]]
+}
+
+// NewPeriodIndex creates empty PeriodIndex
+func NewPeriodIndex[V comparable]() *PeriodIndex[V] {
+	return &PeriodIndex[V]{}
+}
+
+// Insert adds period with value to the index
+func (idx *PeriodIndex[V]) Insert(period Period, value V) {
+	from, to := periodBounds(period)
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+	idx.tree.insert(from, to, PeriodEntry[V]{Period: period, Value: value})
+}
+
+// Delete removes period with value from the index, one occurrence if it was inserted many times.
+// It returns false when there is no such entry.
+func (idx *PeriodIndex[V]) Delete(period Period, value V) bool {
+	from, to := periodBounds(period)
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+	return idx.tree.delete(from, to, PeriodEntry[V]{Period: period, Value: value})
+}
+
+// Len returns the number of entries in the index
+func (idx *PeriodIndex[V]) Len() int {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+	return idx.tree.size
+}
+
+// Overlapping returns entries having any day in common with period, ordered by start
+func (idx *PeriodIndex[V]) Overlapping(period Period) []PeriodEntry[V] {
+	return idx.query(periodBounds(period))
+}
+
+// Stabbing returns entries containing given day, ordered by start
+func (idx *PeriodIndex[V]) Stabbing(date LocalDate) []PeriodEntry[V] {
+	return idx.query(date.Start(), date.End())
+}
+
+// Containing returns entries containing the day of given moment, ordered by start
+func (idx *PeriodIndex[V]) Containing(ldt LocalDateTime) []PeriodEntry[V] {
+	return idx.Stabbing(ldt.Date())
+}
+
+func (idx *PeriodIndex[V]) query(from, to LocalDateTime) []PeriodEntry[V] {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+	var entries []PeriodEntry[V]
+	idx.tree.overlapping(from, to, func(n *intervalNode[PeriodEntry[V]]) {
+		entries = append(entries, n.entry)
+	})
+	return entries
+}
+
+// periodBounds returns period as half-open interval from start of its first day to start of the day after
+func periodBounds(period Period) (LocalDateTime, LocalDateTime) {
+	if period.IsOpen() {
+		return period.from.Start(), NullLocalDateTime
+	}
+	return period.from.Start(), period.to.End()
+}
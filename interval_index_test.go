@@ -0,0 +1,169 @@
+package time
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpanIndexQueries(t *testing.T) {
+	idx := NewSpanIndex[string]()
+	idx.Insert(MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 12:00"), "a")
+	idx.Insert(MustParseDateTimeSpan("2018-01-01 11:00/2018-01-01 11:30"), "b")
+	idx.Insert(MustParseDateTimeSpan("2018-01-01 12:00/2018-01-02 09:00"), "c")
+	idx.Insert(MustParseDateTimeSpan("2018-01-03 08:00/.."), "d")
+	idx.Insert(MustParseDateTimeSpan("2018-01-01 13:00/2018-01-01 13:00"), "empty")
+	assert.Equal(t, 5, idx.Len())
+
+	values := func(entries []SpanEntry[string]) []string {
+		var values []string
+		for _, entry := range entries {
+			values = append(values, entry.Value)
+		}
+		return values
+	}
+	assert.Equal(t, []string{"a", "b"}, values(idx.Overlapping(MustParseDateTimeSpan("2018-01-01 11:15/2018-01-01 12:00"))))
+	assert.Equal(t, []string{"c", "empty", "d"}, values(idx.Overlapping(MustParseDateTimeSpan("2018-01-01 12:00/.."))))
+	assert.Nil(t, idx.Overlapping(MustParseDateTimeSpan("2018-01-02 09:00/2018-01-03 08:00")))
+	assert.Equal(t, []string{"a"}, values(idx.Containing(MustParseLocalDateTime("2018-01-01 10:00"))))
+	assert.Equal(t, []string{"c"}, values(idx.Containing(MustParseLocalDateTime("2018-01-01 13:00"))))
+	assert.Equal(t, []string{"d"}, values(idx.Containing(MustParseLocalDateTime("2030-01-01 00:00"))))
+	assert.Equal(t, []string{"c"}, values(idx.Stabbing(MustParseLocalDate("2018-01-02"))))
+	assert.Equal(t, []string{"a", "b", "c", "empty"}, values(idx.Stabbing(MustParseLocalDate("2018-01-01"))))
+
+	assert.True(t, idx.Delete(MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 12:00"), "a"))
+	assert.False(t, idx.Delete(MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 12:00"), "a"))
+	assert.False(t, idx.Delete(MustParseDateTimeSpan("2018-01-01 11:00/2018-01-01 11:30"), "c"))
+	assert.Equal(t, []string{"b", "c", "empty"}, values(idx.Stabbing(MustParseLocalDate("2018-01-01"))))
+	assert.Equal(t, 4, idx.Len())
+}
+
+func TestPeriodIndexQueries(t *testing.T) {
+	idx := NewPeriodIndex[int]()
+	idx.Insert(MustParsePeriod("2018-01-01/2018-01-31"), 1)
+	idx.Insert(MustParsePeriod("2018-01-31/2018-02-28"), 2)
+	idx.Insert(MustParsePeriod("2018-03-01/.."), 3)
+	idx.Insert(MustParsePeriod("2018-01-31/2018-02-28"), 2)
+
+	values := func(entries []PeriodEntry[int]) []int {
+		var values []int
+		for _, entry := range entries {
+			values = append(values, entry.Value)
+		}
+		return values
+	}
+	assert.Equal(t, []int{1, 2, 2}, values(idx.Stabbing(MustParseLocalDate("2018-01-31"))))
+	assert.Equal(t, []int{2, 2}, values(idx.Containing(MustParseLocalDateTime("2018-02-28 23:59"))))
+	assert.Equal(t, []int{3}, values(idx.Stabbing(MustParseLocalDate("2030-01-01"))))
+	assert.Equal(t, []int{2, 2, 3}, values(idx.Overlapping(MustParsePeriod("2018-02-01/.."))))
+	assert.Nil(t, idx.Overlapping(MustParsePeriod("2017-01-01/2017-12-31")))
+
+	assert.True(t, idx.Delete(MustParsePeriod("2018-01-31/2018-02-28"), 2))
+	assert.Equal(t, []int{1, 2}, values(idx.Stabbing(MustParseLocalDate("2018-01-31"))))
+}
+
+// randomSpans returns spans of up to 3 hours starting within about a year from 2018-01-01
+func randomSpans(random *rand.Rand, count int) []DateTimeSpan {
+	start := MustParseLocalDateTime("2018-01-01 00:00")
+	spans := make([]DateTimeSpan, count)
+	for i := range spans {
+		from := start.Add(Duration(random.Intn(365*24*4)) * 15 * Minute)
+		spans[i] = NewDateTimeSpan(from, from.Add(Duration(random.Intn(12))*15*Minute))
+	}
+	return spans
+}
+
+func linearOverlapping(spans []DateTimeSpan, span DateTimeSpan) []int {
+	var found []int
+	for i, s := range spans {
+		if s.Overlaps(span) {
+			found = append(found, i)
+		}
+	}
+	return found
+}
+
+func TestSpanIndexMatchesLinearScan(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	spans := randomSpans(random, 2000)
+	idx := NewSpanIndex[int]()
+	for i, span := range spans {
+		idx.Insert(span, i)
+	}
+	deleted := map[int]bool{}
+	for i := 0; i < len(spans); i += 3 {
+		assert.True(t, idx.Delete(spans[i], i))
+		deleted[i] = true
+	}
+
+	for _, query := range randomSpans(random, 200) {
+		found := map[int]bool{}
+		var previous LocalDateTime
+		for _, entry := range idx.Overlapping(query) {
+			found[entry.Value] = true
+			assert.False(t, entry.Span.From().Before(previous))
+			previous = entry.Span.From()
+		}
+		for _, i := range linearOverlapping(spans, query) {
+			assert.Equal(t, !deleted[i], found[i])
+			delete(found, i)
+		}
+		assert.Empty(t, found)
+	}
+}
+
+func TestSpanIndexConcurrentReaders(t *testing.T) {
+	spans := randomSpans(rand.New(rand.NewSource(2)), 1000)
+	idx := NewSpanIndex[int]()
+	for i, span := range spans {
+		idx.Insert(span, i)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for _, span := range spans[i*100 : (i+1)*100] {
+				assert.Len(t, idx.Overlapping(span), len(linearOverlapping(spans, span)))
+			}
+		}(i)
+	}
+	idx.Insert(MustParseDateTimeSpan("2030-01-01 00:00/2030-01-02 00:00"), -1)
+	wg.Wait()
+}
+
+func benchmarkSpans(b *testing.B) ([]DateTimeSpan, []DateTimeSpan) {
+	random := rand.New(rand.NewSource(3))
+	return randomSpans(random, 50000), randomSpans(random, 1000)
+}
+
+func BenchmarkSpanIndexOverlapping(b *testing.B) {
+	spans, queries := benchmarkSpans(b)
+	idx := NewSpanIndex[int]()
+	for i, span := range spans {
+		idx.Insert(span, i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		idx.Overlapping(queries[i%len(queries)])
+	}
+}
+
+func BenchmarkLinearOverlaps(b *testing.B) {
+	spans, queries := benchmarkSpans(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		linearOverlapping(spans, queries[i%len(queries)])
+	}
+}
+
+func BenchmarkSpanIndexInsert(b *testing.B) {
+	spans, _ := benchmarkSpans(b)
+	idx := NewSpanIndex[int]()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		idx.Insert(spans[i%len(spans)], i)
+	}
+}
@@ -0,0 +1,169 @@
+package time
+
+// intervalTree is an AVL tree of half-open intervals [from, to) ordered by start,
+// where each node keeps the latest end in its subtree, so that intervals overlapping
+// a query are found in O(log n + k). Interval with to equal to NullLocalDateTime never ends.
+type intervalTree[E comparable] struct {
+	root *intervalNode[E]
+	seq  uint64
+	size int
+}
+
+type intervalNode[E comparable] struct {
+	from, to LocalDateTime
+	// seq orders intervals with the same start by insertion
+	seq   uint64
+	entry E
+	// maxTo is the latest end in the subtree, NullLocalDateTime when any interval in it never ends
+	maxTo       LocalDateTime
+	height      int
+	left, right *intervalNode[E]
+}
+
+func (t *intervalTree[E]) insert(from, to LocalDateTime, entry E) {
+	t.seq++
+	t.size++
+	t.root = t.root.insert(&intervalNode[E]{from: from, to: to, seq: t.seq, entry: entry, maxTo: to, height: 1})
+}
+
+// delete removes the first inserted interval with given bounds and entry, false when there is none
+func (t *intervalTree[E]) delete(from, to LocalDateTime, entry E) bool {
+	found := t.root.find(from, to, entry)
+	if found == nil {
+		return false
+	}
+	t.size--
+	t.root = t.root.delete(found.from, found.seq)
+	return true
+}
+
+// overlapping calls fn in order of start for intervals starting before to and ending after from,
+// as in DateTimeSpan.Overlaps
+func (t *intervalTree[E]) overlapping(from, to LocalDateTime, fn func(n *intervalNode[E])) {
+	t.root.overlapping(from, to, fn)
+}
+
+func (n *intervalNode[E]) overlapping(from, to LocalDateTime, fn func(n *intervalNode[E])) {
+	if n == nil || !n.maxTo.IsNull() && !n.maxTo.After(from) {
+		return
+	}
+	n.left.overlapping(from, to, fn)
+	if !to.IsNull() && !n.from.Before(to) {
+		// this node and the right subtree start too late
+		return
+	}
+	if n.to.IsNull() || n.to.After(from) {
+		fn(n)
+	}
+	n.right.overlapping(from, to, fn)
+}
+
+func (n *intervalNode[E]) find(from, to LocalDateTime, entry E) *intervalNode[E] {
+	if n == nil {
+		return nil
+	}
+	if n.from.After(from) {
+		return n.left.find(from, to, entry)
+	}
+	if n.from.Before(from) {
+		return n.right.find(from, to, entry)
+	}
+	if found := n.left.find(from, to, entry); found != nil {
+		return found
+	}
+	if n.to == to && n.entry == entry {
+		return n
+	}
+	return n.right.find(from, to, entry)
+}
+
+func (n *intervalNode[E]) less(from LocalDateTime, seq uint64) bool {
+	return n.from.Before(from) || n.from == from && n.seq < seq
+}
+
+func (n *intervalNode[E]) insert(node *intervalNode[E]) *intervalNode[E] {
+	if n == nil {
+		return node
+	}
+	if node.less(n.from, n.seq) {
+		n.left = n.left.insert(node)
+	} else {
+		n.right = n.right.insert(node)
+	}
+	return n.rebalance()
+}
+
+func (n *intervalNode[E]) delete(from LocalDateTime, seq uint64) *intervalNode[E] {
+	switch {
+	case n.less(from, seq):
+		n.right = n.right.delete(from, seq)
+	case n.from != from || n.seq != seq:
+		n.left = n.left.delete(from, seq)
+	case n.left == nil:
+		return n.right
+	case n.right == nil:
+		return n.left
+	default:
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		successor.right = n.right.delete(successor.from, successor.seq)
+		successor.left = n.left
+		return successor.rebalance()
+	}
+	return n.rebalance()
+}
+
+func (n *intervalNode[E]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes height and maxTo from children
+func (n *intervalNode[E]) update() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+	n.maxTo = n.to
+	for _, child := range []*intervalNode[E]{n.left, n.right} {
+		if child != nil && !n.maxTo.IsNull() && (child.maxTo.IsNull() || child.maxTo.After(n.maxTo)) {
+			n.maxTo = child.maxTo
+		}
+	}
+}
+
+func (n *intervalNode[E]) rebalance() *intervalNode[E] {
+	n.update()
+	switch balance := n.left.getHeight() - n.right.getHeight(); {
+	case balance > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case balance < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (n *intervalNode[E]) rotateLeft() *intervalNode[E] {
+	root := n.right
+	n.right = root.left
+	root.left = n
+	n.update()
+	root.update()
+	return root
+}
+
+func (n *intervalNode[E]) rotateRight() *intervalNode[E] {
+	root := n.left
+	n.left = root.right
+	root.right = n
+	n.update()
+	root.update()
+	return root
+}
@@ -0,0 +1,60 @@
+package time
+
+import "iter"
+
+// EachDay yields days of period in order without allocating them up front.
+// Days of an open period are yielded indefinitely, so the loop must break or the period
+// must be bounded with Until first.
+func (p Period) EachDay() iter.Seq[LocalDate] {
+	return func(yield func(LocalDate) bool) {
+		for day := p.from; p.IsOpen() || day.BeforeOrEqual(p.to); day = day.Next() {
+			if !yield(day) {
+				return
+			}
+		}
+	}
+}
+
+// EachDayBackward yields days of period from the last one to the first one.
+// Open period has no last day, so nothing is yielded for it, it must be bounded with Until first.
+func (p Period) EachDayBackward() iter.Seq[LocalDate] {
+	return func(yield func(LocalDate) bool) {
+		if p.IsOpen() {
+			return
+		}
+		for day := p.to; p.from.BeforeOrEqual(day); day = day.AddDate(0, 0, -1) {
+			if !yield(day) {
+				return
+			}
+		}
+	}
+}
+
+// EachWeek yields weeks of period starting on startDay, as in SplitByWeek.
+// Weeks of an open period are yielded indefinitely, the last one never comes.
+func (p Period) EachWeek(startDay Weekday) iter.Seq[Period] {
+	return p.piecesAt(nextWeek(startDay))
+}
+
+// EachMonth yields calendar months of period, as in SplitByMonth.
+// Months of an open period are yielded indefinitely, the last one never comes.
+func (p Period) EachMonth() iter.Seq[Period] {
+	return p.piecesAt(nextMonth)
+}
+
+// Steps yields moments of span from its start every step, before its end,
+// e.g. 10:00, 10:15, 10:30 and 10:45 for 10:00-11:00 and 15 minutes.
+// Moments of an open-ended span are yielded indefinitely. Nothing is yielded when step is not positive
+// or when span is open-started, as it has no first moment.
+func (ts DateTimeSpan) Steps(step Duration) iter.Seq[LocalDateTime] {
+	return func(yield func(LocalDateTime) bool) {
+		if step <= 0 || ts.from.IsNull() {
+			return
+		}
+		for ldt := ts.from; ts.to.IsNull() || ldt.Before(ts.to); ldt = ldt.Add(step) {
+			if !yield(ldt) {
+				return
+			}
+		}
+	}
+}
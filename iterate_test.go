@@ -0,0 +1,76 @@
+package time
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodEachDay(t *testing.T) {
+	period := MustParsePeriod("2018-02-27/2018-03-02")
+	expected := []LocalDate{
+		MustParseLocalDate("2018-02-27"),
+		MustParseLocalDate("2018-02-28"),
+		MustParseLocalDate("2018-03-01"),
+		MustParseLocalDate("2018-03-02"),
+	}
+	assert.Equal(t, expected, slices.Collect(period.EachDay()))
+	assert.Equal(t, expected, period.Days())
+	slices.Reverse(expected)
+	assert.Equal(t, expected, slices.Collect(period.EachDayBackward()))
+
+	var days []LocalDate
+	for day := range MustParsePeriod("2018-12-30/..").EachDay() {
+		if len(days) == 3 {
+			break
+		}
+		days = append(days, day)
+	}
+	assert.Equal(t, []LocalDate{MustParseLocalDate("2018-12-30"), MustParseLocalDate("2018-12-31"), MustParseLocalDate("2019-01-01")}, days)
+
+	assert.Nil(t, MustParsePeriod("2018-12-30/..").Days())
+	assert.Empty(t, slices.Collect(MustParsePeriod("2018-12-30/..").EachDayBackward()))
+}
+
+func TestPeriodEachWeekAndMonth(t *testing.T) {
+	period := MustParsePeriod("2018-01-03/2018-03-10")
+	weeks, err := period.SplitByWeek(Monday)
+	assert.NoError(t, err)
+	assert.Equal(t, weeks, slices.Collect(period.EachWeek(Monday)))
+	months, err := period.SplitByMonth()
+	assert.NoError(t, err)
+	assert.Equal(t, months, slices.Collect(period.EachMonth()))
+
+	months = nil
+	for month := range MustParsePeriod("2018-11-15/..").EachMonth() {
+		months = append(months, month)
+		if len(months) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, parsePeriods("2018-11-15/2018-11-30", "2018-12-01/2018-12-31", "2019-01-01/2019-01-31"), months)
+}
+
+func TestDateTimeSpanSteps(t *testing.T) {
+	span := MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 11:00")
+	assert.Equal(t, []LocalDateTime{
+		MustParseLocalDateTime("2018-01-01 10:00"),
+		MustParseLocalDateTime("2018-01-01 10:15"),
+		MustParseLocalDateTime("2018-01-01 10:30"),
+		MustParseLocalDateTime("2018-01-01 10:45"),
+	}, slices.Collect(span.Steps(15*Minute)))
+	assert.Len(t, slices.Collect(span.Steps(25*Minute)), 3)
+
+	var steps []LocalDateTime
+	for ldt := range MustParseDateTimeSpan("2018-01-01 23:00/..").Steps(Hour) {
+		steps = append(steps, ldt)
+		if len(steps) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []LocalDateTime{MustParseLocalDateTime("2018-01-01 23:00"), MustParseLocalDateTime("2018-01-02 00:00")}, steps)
+	assert.Empty(t, slices.Collect(span.Steps(0)))
+	assert.Empty(t, slices.Collect(span.Steps(-Hour)))
+	assert.Empty(t, slices.Collect(MustParseDateTimeSpan("../2018-01-01 10:00").Steps(Hour)))
+}
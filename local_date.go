@@ -3,7 +3,6 @@ package time
 import (
 	"database/sql/driver"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"time"
 
@@ -55,6 +54,11 @@ func (d LocalDate) After(u LocalDate) bool {
 	return d.t.After(u.t)
 }
 
+// Compare returns -1 if date d is before u, 0 if they are equal and +1 if d is after u
+func (d LocalDate) Compare(u LocalDate) int {
+	return d.t.Compare(u.t)
+}
+
 // Next returns the next day
 func (d LocalDate) Next() LocalDate {
 	next := d.t.AddDate(0, 0, 1)
@@ -152,15 +156,12 @@ func (d *LocalDate) unmarshalEasyJSON(in *jlexer.Lexer) {
 }
 
 // Scan implements the Scanner interface.
+// It accepts time.Time as well as textual values returned by drivers
+// that don't convert dates, e.g. "2006-01-02" or "2006-01-02 15:04:05".
 func (d *LocalDate) Scan(value interface{}) error {
-	if value == nil {
-		return errors.New("sql: failed to scan into LocalDate: column is NULL")
-	}
-
-	tt, ok := value.(time.Time)
-	if !ok {
-		return errors.New(
-			"sql: failed to scan into LocalDate: column type is neither time nor date")
+	tt, err := scanTime("LocalDate", value, sqlTimestampFormats)
+	if err != nil {
+		return err
 	}
 	*d = ToLocalDate(tt)
 
@@ -173,8 +174,18 @@ func (d LocalDate) Value() (driver.Value, error) {
 }
 
 // Scan implements the Scanner interface.
+// NULL column makes the date not valid.
 func (d *NullableLocalDate) Scan(value interface{}) error {
-	d.Date.t, d.Valid = value.(time.Time)
+	if value == nil {
+		*d = NullableLocalDate{}
+		return nil
+	}
+	var date LocalDate
+	if err := date.Scan(value); err != nil {
+		*d = NullableLocalDate{}
+		return err
+	}
+	*d = NullableLocalDate{Date: date, Valid: true}
 	return nil
 }
 
@@ -183,8 +194,7 @@ func (d NullableLocalDate) Value() (driver.Value, error) {
 	if !d.Valid {
 		return nil, nil
 	}
-
-	return d.Date.String(), nil
+	return d.Date.Value()
 }
 
 // WithTime creates time.Time instance when combined with LocalTime
@@ -203,3 +213,77 @@ func (d LocalDate) Start() LocalDateTime {
 func (d LocalDate) End() LocalDateTime {
 	return d.Next().Start()
 }
+
+// NewNullableLocalDate creates NullableLocalDate which is valid unless date is NullLocalDate
+func NewNullableLocalDate(date LocalDate) NullableLocalDate {
+	return NullableLocalDate{Date: date, Valid: !date.IsNull()}
+}
+
+// NullableLocalDateFromPtr creates NullableLocalDate which is valid unless date is nil
+func NullableLocalDateFromPtr(date *LocalDate) NullableLocalDate {
+	if date == nil {
+		return NullableLocalDate{}
+	}
+	return NullableLocalDate{Date: *date, Valid: true}
+}
+
+// ValueOr returns the date if it is valid or defaultDate otherwise
+func (d NullableLocalDate) ValueOr(defaultDate LocalDate) LocalDate {
+	if !d.Valid {
+		return defaultDate
+	}
+	return d.Date
+}
+
+// Ptr returns pointer to the date if it is valid or nil otherwise
+func (d NullableLocalDate) Ptr() *LocalDate {
+	if !d.Valid {
+		return nil
+	}
+	date := d.Date
+	return &date
+}
+
+// MarshalJSON marshals the date to JSON, null if it is not valid
+func (d NullableLocalDate) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return d.Date.MarshalJSON()
+}
+
+// UnmarshalJSON parses JSON into the date, null makes it not valid
+func (d *NullableLocalDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = NullableLocalDate{}
+		return nil
+	}
+	var date LocalDate
+	if err := date.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*d = NullableLocalDate{Date: date, Valid: true}
+	return nil
+}
+
+// MarshalText serializes the date to string, empty if it is not valid
+func (d NullableLocalDate) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return d.Date.MarshalText()
+}
+
+// UnmarshalText parses string into the date, empty string makes it not valid
+func (d *NullableLocalDate) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = NullableLocalDate{}
+		return nil
+	}
+	var date LocalDate
+	if err := date.UnmarshalText(text); err != nil {
+		return err
+	}
+	*d = NullableLocalDate{Date: date, Valid: true}
+	return nil
+}
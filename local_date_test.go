@@ -1,6 +1,7 @@
 package time
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -43,3 +44,60 @@ func TestUnmarshalJSON(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, NewLocalDate(2000, 9, 10), d)
 }
+
+func TestNullableLocalDateJSON(t *testing.T) {
+	type document struct {
+		Signed NullableLocalDate `json:"signed"`
+	}
+	JSON, err := json.Marshal(document{Signed: NewNullableLocalDate(NewLocalDate(2018, 1, 2))})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"signed":"2018-01-02"}`, string(JSON))
+	JSON, err = json.Marshal(document{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"signed":null}`, string(JSON))
+
+	var doc document
+	assert.NoError(t, json.Unmarshal([]byte(`{"signed":"2018-01-02"}`), &doc))
+	assert.Equal(t, NullableLocalDate{Date: NewLocalDate(2018, 1, 2), Valid: true}, doc.Signed)
+	assert.NoError(t, json.Unmarshal([]byte(`{"signed":null}`), &doc))
+	assert.False(t, doc.Signed.Valid)
+	assert.Error(t, json.Unmarshal([]byte(`{"signed":"2018-13-02"}`), &doc))
+}
+
+func TestNullableLocalDateScan(t *testing.T) {
+	var d NullableLocalDate
+	assert.NoError(t, d.Scan(time.Date(2018, 1, 2, 15, 4, 5, 0, time.FixedZone("CET", 3600))))
+	assert.Equal(t, NullableLocalDate{Date: NewLocalDate(2018, 1, 2), Valid: true}, d)
+
+	assert.NoError(t, d.Scan([]byte("2018-01-03")))
+	assert.Equal(t, NewNullableLocalDate(NewLocalDate(2018, 1, 3)), d)
+
+	assert.NoError(t, d.Scan(nil))
+	assert.False(t, d.Valid)
+	value, err := d.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	assert.Error(t, d.Scan("not a date"))
+	assert.False(t, d.Valid)
+}
+
+func TestNullableLocalDateAccessors(t *testing.T) {
+	date := NewLocalDate(2018, 1, 2)
+	assert.False(t, NewNullableLocalDate(NullLocalDate).Valid)
+	assert.Equal(t, NewNullableLocalDate(date), NullableLocalDateFromPtr(&date))
+	assert.False(t, NullableLocalDateFromPtr(nil).Valid)
+
+	assert.Equal(t, date, NewNullableLocalDate(date).ValueOr(NullLocalDate))
+	assert.Equal(t, date, NullableLocalDate{}.ValueOr(date))
+	assert.Equal(t, &date, NewNullableLocalDate(date).Ptr())
+	assert.Nil(t, NullableLocalDate{}.Ptr())
+
+	text, _ := NullableLocalDate{}.MarshalText()
+	assert.Equal(t, "", string(text))
+	var d NullableLocalDate
+	assert.NoError(t, d.UnmarshalText([]byte("2018-01-02")))
+	assert.Equal(t, NewNullableLocalDate(date), d)
+	assert.NoError(t, d.UnmarshalText([]byte("")))
+	assert.False(t, d.Valid)
+}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/mailru/easyjson/jlexer"
-	"github.com/pkg/errors"
 )
 
 // LocalDateTime represents a date and time without taking into account a timezone
@@ -14,6 +13,18 @@ type LocalDateTime struct {
 	t time.Time
 }
 
+// LocalDateTimeStyle selects the canonical textual form of LocalDateTime.
+// In every style minutes are always rendered, while seconds and fraction of a second
+// only when they are not zero, so the value can be parsed back without loss.
+type LocalDateTimeStyle int
+
+const (
+	// LocalDateTimeStyleSpace renders date-time as "2006-01-02 15:04:05.999999999"
+	LocalDateTimeStyleSpace LocalDateTimeStyle = iota
+	// LocalDateTimeStyleISO renders date-time in ISO 8601 as "2006-01-02T15:04:05.999999999"
+	LocalDateTimeStyleISO
+)
+
 // NullableLocalDateTime represents a LocalDateTime that may be null.
 // It implements the sql.Scanner interface so it can be used
 // as a scan destination, similar to sql.NullString.
@@ -23,8 +34,18 @@ type NullableLocalDateTime struct {
 }
 
 // Scan implements the Scanner interface.
+// NULL column makes the date-time not valid.
 func (d *NullableLocalDateTime) Scan(value interface{}) error {
-	d.DateTime.t, d.Valid = value.(time.Time)
+	if value == nil {
+		*d = NullableLocalDateTime{}
+		return nil
+	}
+	var dateTime LocalDateTime
+	if err := dateTime.Scan(value); err != nil {
+		*d = NullableLocalDateTime{}
+		return err
+	}
+	*d = NullableLocalDateTime{DateTime: dateTime, Valid: true}
 	return nil
 }
 
@@ -33,7 +54,7 @@ func (d NullableLocalDateTime) Value() (driver.Value, error) {
 	if !d.Valid {
 		return nil, nil
 	}
-	return d.DateTime.String(), nil
+	return d.DateTime.Value()
 }
 
 // NewLocalDateTime creates instances of LocalDateTime
@@ -63,6 +84,11 @@ func (ldt LocalDateTime) Before(other LocalDateTime) bool {
 	return ldt.t.Before(other.t)
 }
 
+// Compare returns -1 if this object is before method argument, 0 if they are equal and +1 if it is after
+func (ldt LocalDateTime) Compare(other LocalDateTime) int {
+	return ldt.t.Compare(other.t)
+}
+
 // Add returns the date-time equal to ldt+d.
 func (ldt LocalDateTime) Add(d Duration) LocalDateTime {
 	return NewLocalDateTime(ldt.t.Add(time.Duration(d)))
@@ -81,7 +107,9 @@ func (ldt LocalDateTime) Sub(u LocalDateTime) Duration {
 }
 
 // GoTime returns converts LocalDateTime to time.Time,
-// keeping same numeric date and time values and sets timezone
+// keeping same numeric date and time values and sets timezone.
+// For date-time in a gap or an overlap the offset is chosen as by time.Date,
+// use AtZone to resolve it explicitly.
 func (ldt LocalDateTime) GoTime(location *time.Location) time.Time {
 	year, month, day := ldt.t.Date()
 	hour, minute, second := ldt.t.Clock()
@@ -90,15 +118,12 @@ func (ldt LocalDateTime) GoTime(location *time.Location) time.Time {
 }
 
 // Scan implements the Scanner interface.
+// It accepts time.Time as well as textual values returned by drivers
+// that don't convert timestamps, e.g. "2006-01-02 15:04:05.999999".
 func (ldt *LocalDateTime) Scan(value interface{}) error {
-	if value == nil {
-		return errors.New("sql: failed to scan into LocalDateTime: column is NULL")
-	}
-
-	tt, ok := value.(time.Time)
-	if !ok {
-		return errors.New(
-			"sql: failed to scan into LocalDateTime: column type is neither time nor date")
+	tt, err := scanTime("LocalDateTime", value, sqlTimestampFormats)
+	if err != nil {
+		return err
 	}
 	*ldt = NewLocalDateTime(tt)
 
@@ -110,8 +135,24 @@ func (ldt LocalDateTime) Value() (driver.Value, error) {
 	return ldt.t.Format("2006-01-02 15:04:05.999999999"), nil
 }
 
+// String renders date-time in LocalDateTimeStyleSpace, e.g. "2018-01-02 15:04" or "2018-01-02 15:04:05.5"
 func (ldt LocalDateTime) String() string {
-	return ldt.Date().String() + " " + ldt.Time().String()
+	return ldt.FormatStyle(LocalDateTimeStyleSpace)
+}
+
+// FormatStyle renders date-time in given style
+func (ldt LocalDateTime) FormatStyle(style LocalDateTimeStyle) string {
+	separator := " "
+	if style == LocalDateTimeStyleISO {
+		separator = "T"
+	}
+	layout := LocalDateFormat + separator + "15:04"
+	if ldt.t.Nanosecond() != 0 {
+		layout += ":05.999999999"
+	} else if ldt.t.Second() != 0 {
+		layout += ":05"
+	}
+	return ldt.t.Format(layout)
 }
 
 // MarshalJSON marshals date to JSON
@@ -119,6 +160,24 @@ func (ldt LocalDateTime) MarshalJSON() ([]byte, error) {
 	return json.Marshal(ldt.String())
 }
 
+// MarshalText serializes date-time to string
+func (ldt LocalDateTime) MarshalText() ([]byte, error) {
+	return []byte(ldt.String()), nil
+}
+
+// UnmarshalText parses string into LocalDateTime
+func (ldt *LocalDateTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	parsedLocalDateTime, err := ParseLocalDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*ldt = parsedLocalDateTime
+	return nil
+}
+
 // UnmarshalJSON parses JSON string into LocalDateTime
 func (ldt *LocalDateTime) UnmarshalJSON(data []byte) error {
 	l := jlexer.Lexer{Data: data}
@@ -138,9 +197,20 @@ func (ldt *LocalDateTime) unmarshalEasyJSON(in *jlexer.Lexer) {
 	}
 }
 
-// ParseLocalDateTime parses string into LocalDateTime
+// ParseLocalDateTime parses string into LocalDateTime. It accepts ISO 8601 form
+// "2006-01-02T15:04:05.999999999" as well as space (or lowercase "t") as separator.
+// Seconds and fraction of a second are optional, e.g. "2006-01-02 15:04".
 func ParseLocalDateTime(datetime string) (LocalDateTime, error) {
-	t, err := time.Parse(LocalDateFormat+" 15:04", datetime)
+	normalized := datetime
+	if len(datetime) > len(LocalDateFormat) {
+		if separator := datetime[len(LocalDateFormat)]; separator == ' ' || separator == 't' {
+			normalized = datetime[:len(LocalDateFormat)] + "T" + datetime[len(LocalDateFormat)+1:]
+		}
+	}
+	if t, err := time.Parse(LocalDateFormat+"T15:04", normalized); err == nil {
+		return NewLocalDateTime(t), nil
+	}
+	t, err := time.Parse(LocalDateFormat+"T15:04:05.999999999", normalized)
 	if err != nil {
 		return NullLocalDateTime, err
 	}
@@ -179,3 +249,77 @@ func Earlier(dateTime1, dateTime2 LocalDateTime) LocalDateTime {
 	}
 	return dateTime2
 }
+
+// NewNullableLocalDateTime creates NullableLocalDateTime which is valid unless dateTime is NullLocalDateTime
+func NewNullableLocalDateTime(dateTime LocalDateTime) NullableLocalDateTime {
+	return NullableLocalDateTime{DateTime: dateTime, Valid: !dateTime.IsNull()}
+}
+
+// NullableLocalDateTimeFromPtr creates NullableLocalDateTime which is valid unless dateTime is nil
+func NullableLocalDateTimeFromPtr(dateTime *LocalDateTime) NullableLocalDateTime {
+	if dateTime == nil {
+		return NullableLocalDateTime{}
+	}
+	return NullableLocalDateTime{DateTime: *dateTime, Valid: true}
+}
+
+// ValueOr returns the date-time if it is valid or defaultDateTime otherwise
+func (d NullableLocalDateTime) ValueOr(defaultDateTime LocalDateTime) LocalDateTime {
+	if !d.Valid {
+		return defaultDateTime
+	}
+	return d.DateTime
+}
+
+// Ptr returns pointer to the date-time if it is valid or nil otherwise
+func (d NullableLocalDateTime) Ptr() *LocalDateTime {
+	if !d.Valid {
+		return nil
+	}
+	dateTime := d.DateTime
+	return &dateTime
+}
+
+// MarshalJSON marshals the date-time to JSON, null if it is not valid
+func (d NullableLocalDateTime) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return d.DateTime.MarshalJSON()
+}
+
+// UnmarshalJSON parses JSON into the date-time, null makes it not valid
+func (d *NullableLocalDateTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = NullableLocalDateTime{}
+		return nil
+	}
+	var dateTime LocalDateTime
+	if err := dateTime.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*d = NullableLocalDateTime{DateTime: dateTime, Valid: true}
+	return nil
+}
+
+// MarshalText serializes the date-time to string, empty if it is not valid
+func (d NullableLocalDateTime) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return d.DateTime.MarshalText()
+}
+
+// UnmarshalText parses string into the date-time, empty string makes it not valid
+func (d *NullableLocalDateTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = NullableLocalDateTime{}
+		return nil
+	}
+	var dateTime LocalDateTime
+	if err := dateTime.UnmarshalText(text); err != nil {
+		return err
+	}
+	*d = NullableLocalDateTime{DateTime: dateTime, Valid: true}
+	return nil
+}
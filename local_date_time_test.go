@@ -34,5 +34,80 @@ func TestUnmarshalJSONOnLocalDateTime(t *testing.T) {
 	assert.Equal(t, expected, actual)
 
 	error = actual.UnmarshalJSON([]byte(`"2016-01-01 12:30:12"`))
+	assert.Nil(t, error)
+	assert.Equal(t, expected.Add(12*Second), actual)
+
+	error = actual.UnmarshalJSON([]byte(`"2016-01-01 12:30:12 CET"`))
 	assert.IsType(t, &time.ParseError{}, error)
 }
+
+func TestParseLocalDateTime(t *testing.T) {
+	expected := NewLocalDateTime(time.Date(2018, 1, 2, 15, 4, 5, 120000000, time.UTC))
+	for _, value := range []string{
+		"2018-01-02T15:04:05.12",
+		"2018-01-02t15:04:05.120",
+		"2018-01-02 15:04:05.12",
+	} {
+		actual, err := ParseLocalDateTime(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, actual, value)
+	}
+	assert.Equal(t, NewLocalDateTime(time.Date(2018, 1, 2, 15, 4, 0, 0, time.UTC)), MustParseLocalDateTime("2018-01-02T15:04"))
+	assert.Equal(t, NewLocalDateTime(time.Date(2018, 1, 2, 15, 4, 5, 0, time.UTC)), MustParseLocalDateTime("2018-01-02 15:04:05"))
+
+	for _, value := range []string{"2018-01-02", "2018-01-02T15", "2018-01-02_15:04", "2018-01-02T15:04Z", "2018-01-02T25:04"} {
+		_, err := ParseLocalDateTime(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestLocalDateTimeString(t *testing.T) {
+	dateTime := MustParseLocalDateTime("2018-01-02 15:04")
+	assert.Equal(t, "2018-01-02 15:04", dateTime.String())
+	assert.Equal(t, "2018-01-02 15:04:05", dateTime.Add(5*Second).String())
+	assert.Equal(t, "2018-01-02 15:04:00.000000001", dateTime.Add(1).String())
+	assert.Equal(t, "2018-01-02T15:04:05.5", dateTime.Add(5500*Millisecond).FormatStyle(LocalDateTimeStyleISO))
+	assert.Equal(t, "2018-01-02T15:04", dateTime.FormatStyle(LocalDateTimeStyleISO))
+}
+
+func TestLocalDateTimeJSONRoundTrip(t *testing.T) {
+	for _, dateTime := range []LocalDateTime{
+		MustParseLocalDateTime("2018-01-02 15:04"),
+		MustParseLocalDateTime("2018-01-02 15:04:05"),
+		MustParseLocalDateTime("2018-01-02 15:04:05.123456789"),
+	} {
+		JSON, err := dateTime.MarshalJSON()
+		assert.NoError(t, err)
+		var actual LocalDateTime
+		assert.NoError(t, actual.UnmarshalJSON(JSON))
+		assert.Equal(t, dateTime, actual, string(JSON))
+	}
+}
+
+func TestNullableLocalDateTime(t *testing.T) {
+	dateTime := MustParseLocalDateTime("2018-01-02 15:04")
+
+	var d NullableLocalDateTime
+	assert.NoError(t, d.UnmarshalJSON([]byte(`"2018-01-02 15:04"`)))
+	assert.Equal(t, NewNullableLocalDateTime(dateTime), d)
+	JSON, err := d.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"2018-01-02 15:04"`, string(JSON))
+
+	assert.NoError(t, d.UnmarshalJSON([]byte(`null`)))
+	assert.False(t, d.Valid)
+	JSON, err = d.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(JSON))
+
+	assert.NoError(t, d.Scan(time.Date(2018, 1, 2, 15, 4, 5, 6, time.FixedZone("CET", 3600))))
+	assert.Equal(t, NewNullableLocalDateTime(NewLocalDateTime(time.Date(2018, 1, 2, 15, 4, 5, 6, time.UTC))), d)
+	value, err := d.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-01-02 15:04:05.000000006", value)
+
+	assert.NoError(t, d.Scan(nil))
+	assert.Equal(t, NullableLocalDateTime{}, d)
+	assert.Equal(t, dateTime, d.ValueOr(dateTime))
+	assert.Nil(t, d.Ptr())
+}
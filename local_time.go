@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/mailru/easyjson/jlexer"
-	"github.com/pkg/errors"
 )
 
 var Midnight = MustCreateNewLocalTime(0, 0)
@@ -20,6 +19,14 @@ type LocalTime struct {
 	minute int
 }
 
+// NullableLocalTime represents a LocalTime that may be null.
+// It implements the sql.Scanner interface so it can be used
+// as a scan destination, similar to sql.NullString.
+type NullableLocalTime struct {
+	Time  LocalTime
+	Valid bool
+}
+
 // NewLocalTime creates instances of LocalTime
 func NewLocalTime(hour, minute int) (LocalTime, error) {
 	if hour < 0 || hour >= 24 {
@@ -89,6 +96,24 @@ func (t LocalTime) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.String())
 }
 
+// MarshalText serializes local time to string
+func (t LocalTime) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText parses string into LocalTime
+func (t *LocalTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	tt, err := ParseLocalTime(string(text))
+	if err != nil {
+		return err
+	}
+	*t = tt
+	return nil
+}
+
 // UnmarshalJSON parses JSON string into LocalTime
 func (t *LocalTime) UnmarshalJSON(data []byte) error {
 	l := jlexer.Lexer{Data: data}
@@ -108,15 +133,13 @@ func (t *LocalTime) unmarshalEasyJSON(in *jlexer.Lexer) {
 }
 
 // Scan implements the Scanner interface.
+// It accepts time.Time as well as textual values returned by drivers
+// that don't convert times, e.g. "15:04:05.999999" returned for PostgreSQL time column.
+// Seconds are truncated.
 func (t *LocalTime) Scan(value interface{}) error {
-	if value == nil {
-		return errors.New("sql: failed to scan into LocalTime: column is NULL")
-	}
-
-	tt, ok := value.(time.Time)
-	if !ok {
-		return errors.New(
-			"sql: failed to scan into LocalTime: column type is neither time nor date")
+	tt, err := scanTime("LocalTime", value, append(sqlTimeFormats, sqlTimestampFormats...))
+	if err != nil {
+		return err
 	}
 	*t = ToLocalTime(tt)
 
@@ -158,3 +181,112 @@ func (t LocalTime) Before(other LocalTime) bool {
 	}
 	return true
 }
+
+// Compare returns -1 if given local time is before method argument, 0 if they are equal and +1 if it is after
+func (t LocalTime) Compare(other LocalTime) int {
+	switch {
+	case t.After(other):
+		return 1
+	case t == other:
+		return 0
+	}
+	return -1
+}
+
+// NewNullableLocalTime creates NullableLocalTime which is valid unless localTime is NullLocalTime
+func NewNullableLocalTime(localTime LocalTime) NullableLocalTime {
+	return NullableLocalTime{Time: localTime, Valid: localTime != NullLocalTime}
+}
+
+// NullableLocalTimeFromPtr creates NullableLocalTime which is valid unless localTime is nil
+func NullableLocalTimeFromPtr(localTime *LocalTime) NullableLocalTime {
+	if localTime == nil {
+		return NullableLocalTime{}
+	}
+	return NullableLocalTime{Time: *localTime, Valid: true}
+}
+
+// ValueOr returns the time if it is valid or defaultTime otherwise
+func (t NullableLocalTime) ValueOr(defaultTime LocalTime) LocalTime {
+	if !t.Valid {
+		return defaultTime
+	}
+	return t.Time
+}
+
+// Ptr returns pointer to the time if it is valid or nil otherwise
+func (t NullableLocalTime) Ptr() *LocalTime {
+	if !t.Valid {
+		return nil
+	}
+	localTime := t.Time
+	return &localTime
+}
+
+// Scan implements the Scanner interface.
+// NULL column makes the time not valid.
+func (t *NullableLocalTime) Scan(value interface{}) error {
+	if value == nil {
+		*t = NullableLocalTime{}
+		return nil
+	}
+	var localTime LocalTime
+	if err := localTime.Scan(value); err != nil {
+		*t = NullableLocalTime{}
+		return err
+	}
+	*t = NullableLocalTime{Time: localTime, Valid: true}
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+func (t NullableLocalTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.Value()
+}
+
+// MarshalJSON marshals the time to JSON, null if it is not valid
+func (t NullableLocalTime) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON parses JSON into the time, null makes it not valid
+func (t *NullableLocalTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = NullableLocalTime{}
+		return nil
+	}
+	var localTime LocalTime
+	if err := localTime.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*t = NullableLocalTime{Time: localTime, Valid: true}
+	return nil
+}
+
+// MarshalText serializes the time to string, empty if it is not valid
+func (t NullableLocalTime) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return t.Time.MarshalText()
+}
+
+// UnmarshalText parses string into the time, empty string makes it not valid
+func (t *NullableLocalTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = NullableLocalTime{}
+		return nil
+	}
+	var localTime LocalTime
+	if err := localTime.UnmarshalText(text); err != nil {
+		return err
+	}
+	*t = NullableLocalTime{Time: localTime, Valid: true}
+	return nil
+}
@@ -1,6 +1,7 @@
 package time
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -42,3 +43,32 @@ func TestScanLocalTime(t *testing.T) {
 	expected, _ := NewLocalTime(23, 45)
 	assert.Equal(t, expected, time1)
 }
+
+func TestNullableLocalTime(t *testing.T) {
+	localTime := MustCreateNewLocalTime(23, 45)
+
+	var n NullableLocalTime
+	assert.NoError(t, n.Scan("23:45:12.345"))
+	assert.Equal(t, NewNullableLocalTime(localTime), n)
+	value, err := n.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "23:45", value)
+
+	assert.NoError(t, n.Scan(nil))
+	assert.False(t, n.Valid)
+	value, err = n.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	JSON, err := json.Marshal([]NullableLocalTime{NewNullableLocalTime(localTime), {}})
+	assert.NoError(t, err)
+	assert.Equal(t, `["23:45",null]`, string(JSON))
+	var times []NullableLocalTime
+	assert.NoError(t, json.Unmarshal(JSON, &times))
+	assert.Equal(t, []NullableLocalTime{NewNullableLocalTime(localTime), {}}, times)
+
+	assert.False(t, NewNullableLocalTime(NullLocalTime).Valid)
+	assert.Equal(t, NewNullableLocalTime(localTime), NullableLocalTimeFromPtr(&localTime))
+	assert.Equal(t, Midnight, NullableLocalTime{}.ValueOr(Midnight))
+	assert.Equal(t, &localTime, NewNullableLocalTime(localTime).Ptr())
+}
@@ -0,0 +1,222 @@
+package time
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Locale provides names of months and weekdays in a language
+// together with patterns used for localised formatting of dates.
+// Months are indexed from January, weekdays from Sunday as in time package.
+type Locale struct {
+	// Tag is a BCP 47 language tag, e.g. "pl" or "en-GB"
+	Tag string
+	// Months are full month names in format context, used with a day,
+	// e.g. "stycznia" in "1 stycznia 2018". Pattern "MMMM".
+	Months [12]string
+	// StandaloneMonths are full month names in nominative, e.g. "styczeń". Pattern "LLLL".
+	StandaloneMonths [12]string
+	// ShortMonths are abbreviated month names. Patterns "MMM" and "LLL".
+	ShortMonths [12]string
+	// Weekdays are full weekday names. Pattern "EEEE".
+	Weekdays [7]string
+	// ShortWeekdays are abbreviated weekday names. Patterns "E" to "EEE".
+	ShortWeekdays [7]string
+	// AmPmMarkers are used with clock hour. Pattern "a".
+	AmPmMarkers [2]string
+	// DatePattern is used by FormatDate, e.g. "d MMMM yyyy"
+	DatePattern string
+	// DateTimePattern is used by FormatDateTime, e.g. "d MMMM yyyy HH:mm"
+	DateTimePattern string
+	// Relative are words used by Humanizer, English ones are used when PluralForm is nil
+	Relative RelativeTexts
+}
+
+// english is the table copied by EnglishLocale, also used when no locale is set
+var english = Locale{
+	Tag: "en",
+	Months: [12]string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+	StandaloneMonths: [12]string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+	ShortMonths:     [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	Weekdays:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortWeekdays:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	AmPmMarkers:     [2]string{"AM", "PM"},
+	DatePattern:     "MMMM d, yyyy",
+	DateTimePattern: "MMMM d, yyyy h:mm a",
+	Relative:        englishRelativeTexts,
+}
+
+// polish is the table copied by PolishLocale
+var polish = Locale{
+	Tag: "pl",
+	Months: [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca",
+		"lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+	StandaloneMonths: [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec",
+		"lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+	ShortMonths:     [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+	Weekdays:        [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+	ShortWeekdays:   [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+	AmPmMarkers:     [2]string{"AM", "PM"},
+	DatePattern:     "d MMMM yyyy",
+	DateTimePattern: "d MMMM yyyy HH:mm",
+	Relative:        polishRelativeTexts,
+}
+
+// EnglishLocale returns a new copy of English locale, which can be changed without affecting other users
+func EnglishLocale() *Locale {
+	return english.clone()
+}
+
+// PolishLocale returns a new copy of Polish locale, which can be changed without affecting other users
+func PolishLocale() *Locale {
+	return polish.clone()
+}
+
+// clone copies locale together with its relative texts
+func (l *Locale) clone() *Locale {
+	copied := *l
+	copied.Relative = l.Relative.clone()
+	return &copied
+}
+
+var (
+	localesMutex sync.RWMutex
+	locales      = map[string]*Locale{}
+)
+
+func init() {
+	MustRegisterLocale(EnglishLocale())
+	MustRegisterLocale(PolishLocale())
+}
+
+// RegisterLocale makes locale available for LookupLocale under its tag.
+// Locale registered earlier with the same tag is replaced.
+func RegisterLocale(locale *Locale) error {
+	if locale.Tag == "" {
+		return fmt.Errorf("Locale must have a tag")
+	}
+	if _, err := locale.FormatDate(NewLocalDate(2000, time.January, 1)); err != nil {
+		return err
+	}
+	if _, err := locale.FormatDateTime(NewLocalDate(2000, time.January, 1).Start()); err != nil {
+		return err
+	}
+	localesMutex.Lock()
+	defer localesMutex.Unlock()
+	locales[normalizeLocaleTag(locale.Tag)] = locale
+	return nil
+}
+
+// MustRegisterLocale is like RegisterLocale but panics on error
+func MustRegisterLocale(locale *Locale) {
+	if err := RegisterLocale(locale); err != nil {
+		panic(err)
+	}
+}
+
+// LookupLocale finds registered locale by tag. When there is no locale for the tag,
+// e.g. "pl-PL", locale for its language ("pl") is returned.
+func LookupLocale(tag string) (*Locale, error) {
+	normalized := normalizeLocaleTag(tag)
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+	for {
+		if locale, ok := locales[normalized]; ok {
+			return locale, nil
+		}
+		separator := strings.LastIndexByte(normalized, '-')
+		if separator < 0 {
+			return nil, fmt.Errorf("Unknown locale: %v", tag)
+		}
+		normalized = normalized[:separator]
+	}
+}
+
+func normalizeLocaleTag(tag string) string {
+	return strings.ToLower(strings.Replace(tag, "_", "-", -1))
+}
+
+func (l *Locale) String() string {
+	return l.Tag
+}
+
+// MonthName returns full month name in format context, e.g. "stycznia"
+func (l *Locale) MonthName(month time.Month) string {
+	return l.Months[month-1]
+}
+
+// StandaloneMonthName returns full month name in nominative, e.g. "styczeń"
+func (l *Locale) StandaloneMonthName(month time.Month) string {
+	return l.StandaloneMonths[month-1]
+}
+
+// WeekdayName returns full weekday name, e.g. "poniedziałek"
+func (l *Locale) WeekdayName(w Weekday) string {
+	return l.Weekdays[w]
+}
+
+// ParseMonth parses full or short month name in any form, ignoring case
+func (l *Locale) ParseMonth(value string) (time.Month, error) {
+	for _, names := range [][12]string{l.Months, l.StandaloneMonths, l.ShortMonths} {
+		for i, name := range names {
+			if strings.EqualFold(name, value) {
+				return time.Month(i + 1), nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("Wrong month name in %v locale: %v", l, value)
+}
+
+// ParseWeekday parses full or short weekday name, ignoring case
+func (l *Locale) ParseWeekday(value string) (Weekday, error) {
+	for _, names := range [][7]string{l.Weekdays, l.ShortWeekdays} {
+		for i, name := range names {
+			if strings.EqualFold(name, value) {
+				return Weekday(i), nil
+			}
+		}
+	}
+	return NotAWeekday, fmt.Errorf("Wrong Weekday format in %v locale: %v", l, value)
+}
+
+// FormatDate formats date with locale's DatePattern, e.g. "1 stycznia 2018"
+func (l *Locale) FormatDate(d LocalDate) (string, error) {
+	formatter, err := NewFormatter(l.DatePattern)
+	if err != nil {
+		return "", err
+	}
+	return formatter.WithLocale(l).FormatDate(d)
+}
+
+// FormatDateTime formats date-time with locale's DateTimePattern, e.g. "1 stycznia 2018 15:04"
+func (l *Locale) FormatDateTime(ldt LocalDateTime) (string, error) {
+	formatter, err := NewFormatter(l.DateTimePattern)
+	if err != nil {
+		return "", err
+	}
+	return formatter.WithLocale(l).FormatDateTime(ldt)
+}
+
+// monthNames returns month names used by pattern field, all forms for parsing
+func (l *Locale) monthNames(field patternField, parsing bool) [][12]string {
+	switch {
+	case field.width == 3:
+		return [][12]string{l.ShortMonths}
+	case parsing:
+		return [][12]string{l.Months, l.StandaloneMonths}
+	case field.letter == 'L':
+		return [][12]string{l.StandaloneMonths}
+	}
+	return [][12]string{l.Months}
+}
+
+func (l *Locale) weekdayNames(field patternField) [7]string {
+	if field.width == 4 {
+		return l.Weekdays
+	}
+	return l.ShortWeekdays
+}
@@ -0,0 +1,109 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocaleFormatsDates(t *testing.T) {
+	date := MustParseLocalDate("2018-01-01")
+	formatted, err := PolishLocale().FormatDate(date)
+	assert.NoError(t, err)
+	assert.Equal(t, "1 stycznia 2018", formatted)
+	formatted, err = EnglishLocale().FormatDate(date)
+	assert.NoError(t, err)
+	assert.Equal(t, "January 1, 2018", formatted)
+
+	dateTime := MustParseLocalDateTime("2018-09-05 14:30")
+	formatted, err = PolishLocale().FormatDateTime(dateTime)
+	assert.NoError(t, err)
+	assert.Equal(t, "5 września 2018 14:30", formatted)
+	formatted, err = EnglishLocale().FormatDateTime(dateTime)
+	assert.NoError(t, err)
+	assert.Equal(t, "September 5, 2018 2:30 PM", formatted)
+
+	formatted, err = MustNewFormatter("EEEE, LLLL yyyy").WithLocale(PolishLocale()).FormatDate(date)
+	assert.NoError(t, err)
+	assert.Equal(t, "poniedziałek, styczeń 2018", formatted)
+	formatted, err = MustNewFormatter("EEE d MMM").WithLocale(PolishLocale()).FormatDate(MustParseLocalDate("2018-10-03"))
+	assert.NoError(t, err)
+	assert.Equal(t, "śr. 3 paź", formatted)
+}
+
+func TestLocaleParsesNames(t *testing.T) {
+	polish := PolishLocale()
+	formatter := MustNewFormatter("EEEE, d MMMM yyyy").WithLocale(polish)
+	assert.Same(t, polish, formatter.Locale())
+	assert.Equal(t, "en", MustNewFormatter("EEEE, d MMMM yyyy").Locale().Tag)
+
+	date, err := formatter.ParseDate("Środa, 3 października 2018")
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseLocalDate("2018-10-03"), date)
+	date, err = formatter.ParseDate("środa, 3 październik 2018")
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseLocalDate("2018-10-03"), date)
+	_, err = formatter.ParseDate("wednesday, 3 October 2018")
+	assert.Error(t, err)
+
+	weekday, err := PolishLocale().ParseWeekday("Poniedziałek")
+	assert.NoError(t, err)
+	assert.Equal(t, Monday, weekday)
+	weekday, err = PolishLocale().ParseWeekday("sob.")
+	assert.NoError(t, err)
+	assert.Equal(t, Saturday, weekday)
+	_, err = PolishLocale().ParseWeekday("monday")
+	assert.EqualError(t, err, "Wrong Weekday format in pl locale: monday")
+
+	month, err := PolishLocale().ParseMonth("lutego")
+	assert.NoError(t, err)
+	assert.Equal(t, time.February, month)
+	month, err = PolishLocale().ParseMonth("Luty")
+	assert.NoError(t, err)
+	assert.Equal(t, time.February, month)
+	month, err = EnglishLocale().ParseMonth("dec")
+	assert.NoError(t, err)
+	assert.Equal(t, time.December, month)
+
+	assert.Equal(t, "stycznia", PolishLocale().MonthName(time.January))
+	assert.Equal(t, "styczeń", PolishLocale().StandaloneMonthName(time.January))
+	assert.Equal(t, "niedziela", PolishLocale().WeekdayName(Sunday))
+}
+
+func TestLocaleRegistry(t *testing.T) {
+	locale, err := LookupLocale("pl_PL")
+	assert.NoError(t, err)
+	assert.Equal(t, "pl", locale.Tag)
+	locale, err = LookupLocale("en-GB")
+	assert.NoError(t, err)
+	assert.Equal(t, "en", locale.Tag)
+	_, err = LookupLocale("de")
+	assert.EqualError(t, err, "Unknown locale: de")
+
+	british := *EnglishLocale()
+	british.Tag = "en-GB"
+	british.DatePattern = "d MMMM yyyy"
+	british.DateTimePattern = "d MMMM yyyy HH:mm"
+	assert.NoError(t, RegisterLocale(&british))
+	locale, err = LookupLocale("EN-gb")
+	assert.NoError(t, err)
+	assert.Equal(t, &british, locale)
+
+	broken := british
+	broken.Tag = "en-US"
+	broken.DatePattern = "d MMMM yyyy HH:mm"
+	assert.EqualError(t, RegisterLocale(&broken), `Pattern "d MMMM yyyy HH:mm" field "HH" is not supported by LocalDate`)
+}
+
+func TestLocaleCopiesAreIndependent(t *testing.T) {
+	changed := EnglishLocale()
+	changed.Months[0] = "Jan."
+	changed.Relative.UnitNames[UnitDay][0] = "d."
+	assert.Equal(t, "January", EnglishLocale().MonthName(time.January))
+	assert.Equal(t, "day", EnglishLocale().Relative.UnitNames[UnitDay][0])
+	assert.Equal(t, "1 day", NewHumanizer(NewClock()).Duration(24*Hour))
+	formatted, err := MustNewFormatter("MMMM").FormatDate(MustParseLocalDate("2018-01-03"))
+	assert.NoError(t, err)
+	assert.Equal(t, "January", formatted)
+}
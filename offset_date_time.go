@@ -0,0 +1,209 @@
+package time
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// maxOffset is the largest offset from UTC in seconds, as in ISO 8601
+const maxOffset = 18 * 60 * 60
+
+// offsetDateTimeFormats are RFC 3339 layouts accepted by ParseOffsetDateTime,
+// after separator is normalized to "T"
+var offsetDateTimeFormats = []string{
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999Z07",
+	"2006-01-02T15:04Z07:00",
+}
+
+// OffsetDateTime represents a date and time with a fixed offset from UTC,
+// e.g. 2018-01-02T15:04:05+01:00. Unlike ZonedDateTime it keeps the offset
+// it was created with rather than a time zone.
+type OffsetDateTime struct {
+	dateTime LocalDateTime
+	offset   int
+}
+
+// NewOffsetDateTime creates OffsetDateTime from local date-time and offset in seconds east of UTC
+func NewOffsetDateTime(dateTime LocalDateTime, offset int) (OffsetDateTime, error) {
+	if offset < -maxOffset || offset > maxOffset {
+		return OffsetDateTime{}, fmt.Errorf("offset must be between -18:00 and +18:00! Was: %v", offset)
+	}
+	return OffsetDateTime{dateTime: dateTime, offset: offset}, nil
+}
+
+// AtOffset combines date-time with offset in seconds east of UTC
+func (ldt LocalDateTime) AtOffset(offset int) (OffsetDateTime, error) {
+	return NewOffsetDateTime(ldt, offset)
+}
+
+// ToOffsetDateTime converts go's time.Time to OffsetDateTime keeping its current offset
+func ToOffsetDateTime(t time.Time) OffsetDateTime {
+	_, offset := t.Zone()
+	return OffsetDateTime{dateTime: NewLocalDateTime(t), offset: offset}
+}
+
+// OffsetDateTime returns date-time with the offset currently in effect in its zone
+func (zdt ZonedDateTime) OffsetDateTime() OffsetDateTime {
+	return OffsetDateTime{dateTime: zdt.dateTime, offset: zdt.offset}
+}
+
+// ParseOffsetDateTime parses RFC 3339 date-time, e.g. "2018-01-02T15:04:05+01:00".
+// It also accepts a space or lowercase "t" as separator, missing seconds
+// and offsets in form of "+0100" or "+01".
+func ParseOffsetDateTime(value string) (OffsetDateTime, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if len(normalized) > 10 && normalized[10] == ' ' {
+		normalized = normalized[:10] + "T" + normalized[11:]
+	}
+	for _, format := range offsetDateTimeFormats {
+		if t, err := time.Parse(format, normalized); err == nil {
+			return ToOffsetDateTime(t), nil
+		}
+	}
+	_, err := time.Parse(time.RFC3339, normalized)
+	return OffsetDateTime{}, err
+}
+
+// MustParseOffsetDateTime is like ParseOffsetDateTime but panics on error
+func MustParseOffsetDateTime(value string) OffsetDateTime {
+	odt, err := ParseOffsetDateTime(value)
+	if err != nil {
+		panic(err)
+	}
+	return odt
+}
+
+// LocalDateTime returns date and time without offset
+func (odt OffsetDateTime) LocalDateTime() LocalDateTime {
+	return odt.dateTime
+}
+
+// Offset returns offset from UTC in seconds east of UTC
+func (odt OffsetDateTime) Offset() int {
+	return odt.offset
+}
+
+// GoTime converts OffsetDateTime to time.Time in a fixed zone with the same offset
+func (odt OffsetDateTime) GoTime() time.Time {
+	return odt.dateTime.GoTime(time.FixedZone("", odt.offset))
+}
+
+// AtZone returns date-time in given time zone denoting the same instant
+func (odt OffsetDateTime) AtZone(location *time.Location) ZonedDateTime {
+	return ToZonedDateTime(odt.GoTime().In(location))
+}
+
+// WithOffsetSameInstant returns date-time with given offset denoting the same instant
+func (odt OffsetDateTime) WithOffsetSameInstant(offset int) (OffsetDateTime, error) {
+	if _, err := NewOffsetDateTime(odt.dateTime, offset); err != nil {
+		return OffsetDateTime{}, err
+	}
+	return ToOffsetDateTime(odt.GoTime().In(time.FixedZone("", offset))), nil
+}
+
+// Before reports whether odt is before other instant
+func (odt OffsetDateTime) Before(other OffsetDateTime) bool {
+	return odt.GoTime().Before(other.GoTime())
+}
+
+// After reports whether odt is after other instant
+func (odt OffsetDateTime) After(other OffsetDateTime) bool {
+	return odt.GoTime().After(other.GoTime())
+}
+
+// Equal reports whether odt and other denote the same instant, regardless of offsets
+func (odt OffsetDateTime) Equal(other OffsetDateTime) bool {
+	return odt.GoTime().Equal(other.GoTime())
+}
+
+// String formats date-time in RFC 3339 with fraction of a second when it is not zero,
+// e.g. "2018-01-02T15:04:05+01:00". RFC 3339 offsets have no seconds, so an offset which
+// is not whole minutes, e.g. local mean time of historical dates, is rounded to the nearest
+// minute and date-time is shifted to denote the same instant.
+func (odt OffsetDateTime) String() string {
+	if rounded := roundOffsetToMinutes(odt.offset); rounded != odt.offset {
+		odt = ToOffsetDateTime(odt.GoTime().In(time.FixedZone("", rounded)))
+	}
+	return odt.dateTime.t.Format("2006-01-02T15:04:05.999999999") + formatOffset(odt.offset)
+}
+
+// roundOffsetToMinutes rounds offset in seconds to the nearest minute, half away from zero
+func roundOffsetToMinutes(offset int) int {
+	if offset < 0 {
+		return -roundOffsetToMinutes(-offset)
+	}
+	return (offset + 30) / 60 * 60
+}
+
+// MarshalText serializes date-time to RFC 3339 string
+func (odt OffsetDateTime) MarshalText() ([]byte, error) {
+	return []byte(odt.String()), nil
+}
+
+// UnmarshalText parses RFC 3339 string into date-time
+func (odt *OffsetDateTime) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	parsed, err := ParseOffsetDateTime(string(text))
+	if err != nil {
+		return err
+	}
+	*odt = parsed
+	return nil
+}
+
+// MarshalJSON marshals date-time to JSON string in RFC 3339
+func (odt OffsetDateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(odt.String())
+}
+
+// UnmarshalJSON parses JSON string in RFC 3339 into date-time
+func (odt *OffsetDateTime) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	odt.unmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+func (odt *OffsetDateTime) unmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		parsed, err := ParseOffsetDateTime(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*odt = parsed
+	}
+}
+
+// Scan implements the Scanner interface.
+// PostgreSQL timestamptz doesn't store the original offset, so scanned value
+// has the offset of the session time zone.
+func (odt *OffsetDateTime) Scan(value interface{}) error {
+	if t, ok := value.(time.Time); ok {
+		*odt = ToOffsetDateTime(t)
+		return nil
+	}
+	text, err := scanText("OffsetDateTime", value)
+	if err != nil {
+		return err
+	}
+	parsed, err := ParseOffsetDateTime(text)
+	if err != nil {
+		return fmt.Errorf("sql: failed to scan into OffsetDateTime: %v", err)
+	}
+	*odt = parsed
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+func (odt OffsetDateTime) Value() (driver.Value, error) {
+	return odt.GoTime(), nil
+}
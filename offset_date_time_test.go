@@ -0,0 +1,114 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOffsetDateTime(t *testing.T) {
+	expected, err := MustParseLocalDateTime("2018-01-02 15:04").Add(5 * Second).AtOffset(3600)
+	assert.NoError(t, err)
+	for _, value := range []string{
+		"2018-01-02T15:04:05+01:00",
+		"2018-01-02t15:04:05+01:00",
+		"2018-01-02 15:04:05+01:00",
+		"2018-01-02T15:04:05+0100",
+		"2018-01-02 15:04:05+01",
+		"2018-01-02T15:04:05.000+01:00",
+	} {
+		actual, err := ParseOffsetDateTime(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, actual, value)
+	}
+
+	utc, err := ParseOffsetDateTime("2018-01-02T14:04:05z")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, utc.Offset())
+	assert.True(t, utc.Equal(expected))
+	assert.NotEqual(t, utc, expected)
+
+	minutes, err := ParseOffsetDateTime("2018-01-02T15:04-03:30")
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-01-02T15:04:00-03:30", minutes.String())
+
+	_, err = ParseOffsetDateTime("2018-01-02T15:04:05")
+	assert.Error(t, err)
+	_, err = ParseOffsetDateTime("2018-01-02")
+	assert.Error(t, err)
+}
+
+func TestOffsetDateTimeFormatting(t *testing.T) {
+	odt := MustParseOffsetDateTime("2018-01-02T15:04:05.123+01:00")
+	assert.Equal(t, "2018-01-02T15:04:05.123+01:00", odt.String())
+	assert.Equal(t, "2018-01-02T14:04:05Z", MustParseOffsetDateTime("2018-01-02T14:04:05Z").String())
+
+	JSON, err := odt.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"2018-01-02T15:04:05.123+01:00"`, string(JSON))
+	var actual OffsetDateTime
+	assert.NoError(t, actual.UnmarshalJSON(JSON))
+	assert.Equal(t, odt, actual)
+	assert.Error(t, actual.UnmarshalJSON([]byte(`"2018-01-02 15:04"`)))
+
+	text, _ := odt.MarshalText()
+	assert.NoError(t, actual.UnmarshalText(text))
+	assert.Equal(t, odt, actual)
+
+	lmt := ToOffsetDateTime(time.Date(2018, 1, 2, 12, 0, 0, 0, time.FixedZone("", 19*60+32)))
+	assert.Equal(t, "2018-01-02T12:00:28+00:20", lmt.String())
+	text, _ = lmt.MarshalText()
+	assert.NoError(t, actual.UnmarshalText(text))
+	assert.True(t, lmt.Equal(actual))
+	west := ToOffsetDateTime(time.Date(2018, 1, 2, 12, 0, 0, 0, time.FixedZone("", -(19*60+30))))
+	assert.Equal(t, "2018-01-02T11:59:30-00:20", west.String())
+	JSON, _ = west.MarshalJSON()
+	assert.NoError(t, actual.UnmarshalJSON(JSON))
+	assert.True(t, west.Equal(actual))
+}
+
+func TestOffsetDateTimeComparison(t *testing.T) {
+	warsawNoon := MustParseOffsetDateTime("2018-01-02T12:00:00+01:00")
+	londonNoon := MustParseOffsetDateTime("2018-01-02T12:00:00Z")
+	assert.True(t, warsawNoon.Before(londonNoon))
+	assert.True(t, londonNoon.After(warsawNoon))
+	assert.True(t, warsawNoon.Equal(MustParseOffsetDateTime("2018-01-02T11:00:00Z")))
+}
+
+func TestOffsetDateTimeConversions(t *testing.T) {
+	odt := MustParseOffsetDateTime("2018-07-02T15:04:05+01:00")
+	assert.Equal(t, MustParseLocalDateTime("2018-07-02 15:04").Add(5*Second), odt.LocalDateTime())
+	assert.Equal(t, time.Date(2018, 7, 2, 14, 4, 5, 0, time.UTC).Unix(), odt.GoTime().Unix())
+
+	zdt := odt.AtZone(warsaw(t))
+	assert.Equal(t, "2018-07-02T16:04:05+02:00[Europe/Warsaw]", zdt.String())
+	assert.Equal(t, MustParseOffsetDateTime("2018-07-02T16:04:05+02:00"), zdt.OffsetDateTime())
+
+	utc, err := odt.WithOffsetSameInstant(0)
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-07-02T14:04:05Z", utc.String())
+	_, err = odt.WithOffsetSameInstant(19 * 3600)
+	assert.Error(t, err)
+
+	_, err = NewOffsetDateTime(odt.LocalDateTime(), -19*3600)
+	assert.Error(t, err)
+}
+
+func TestOffsetDateTimeScanAndValue(t *testing.T) {
+	expected := MustParseOffsetDateTime("2018-01-02T15:04:05.123456+01:00")
+
+	var actual OffsetDateTime
+	assert.NoError(t, actual.Scan(time.Date(2018, 1, 2, 15, 4, 5, 123456000, time.FixedZone("CET", 3600))))
+	assert.Equal(t, expected, actual)
+	assert.NoError(t, actual.Scan([]byte("2018-01-02 15:04:05.123456+01")))
+	assert.Equal(t, expected, actual)
+	assert.Error(t, actual.Scan("2018-01-02"))
+	assert.Error(t, actual.Scan(nil))
+
+	value, err := expected.Value()
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2018, 1, 2, 14, 4, 5, 123456000, time.UTC).Equal(value.(time.Time)))
+	_, offset := value.(time.Time).Zone()
+	assert.Equal(t, 3600, offset)
+}
@@ -1,7 +1,12 @@
 package time
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 )
 
 // Period represents a set of days between two dates (inclusive)
@@ -63,13 +68,13 @@ func MustNewOneDayPeriod(date LocalDate) Period {
 	return MustNewPeriod(date, date)
 }
 
-// Days returns a slice of all days in a period in order
+// Days returns a slice of all days in a period in order.
+// Open period has infinitely many days, so nil is returned for it, use EachDay or bound it with Until first.
 func (p Period) Days() []LocalDate {
-	var days []LocalDate
-	for current := p.from; current.BeforeOrEqual(p.to); current = current.Next() {
-		days = append(days, current)
+	if p.IsOpen() {
+		return nil
 	}
-	return days
+	return slices.Collect(p.EachDay())
 }
 
 // Contains tells if period contains given date
@@ -77,6 +82,96 @@ func (p Period) Contains(date LocalDate) bool {
 	return p.from.BeforeOrEqual(date) && (date.BeforeOrEqual(p.to) || p.to.IsNull())
 }
 
+// Overlaps tells if periods have at least one common day
+func (p Period) Overlaps(other Period) bool {
+	return !p.endsBefore(other.from) && !other.endsBefore(p.from)
+}
+
+// IsAdjacent tells if periods have no common day and one of them starts on the day after the other ends
+func (p Period) IsAdjacent(other Period) bool {
+	return (!p.IsOpen() && p.to.Next() == other.from) || (!other.IsOpen() && other.to.Next() == p.from)
+}
+
+// Encloses tells if period contains all days of other period
+func (p Period) Encloses(other Period) bool {
+	return p.from.BeforeOrEqual(other.from) && (p.IsOpen() || !other.IsOpen() && other.to.BeforeOrEqual(p.to))
+}
+
+// Intersection returns days common to both periods, false when periods don't overlap
+func (p Period) Intersection(other Period) (Period, bool) {
+	if !p.Overlaps(other) {
+		return Period{from: NullLocalDate, to: NullLocalDate}, false
+	}
+	from := p.from
+	if other.from.After(from) {
+		from = other.from
+	}
+	to := p.to
+	if p.IsOpen() || !other.IsOpen() && other.to.BeforeOrEqual(p.to) {
+		to = other.to
+	}
+	return Period{from: from, to: to}, true
+}
+
+// Union returns period made of days of both periods, false when periods neither overlap nor are adjacent
+func (p Period) Union(other Period) (Period, bool) {
+	if !p.Overlaps(other) && !p.IsAdjacent(other) {
+		return Period{from: NullLocalDate, to: NullLocalDate}, false
+	}
+	from := p.from
+	if other.from.BeforeOrEqual(from) {
+		from = other.from
+	}
+	to := p.to
+	if p.IsOpen() || other.IsOpen() {
+		to = NullLocalDate
+	} else if other.to.After(to) {
+		to = other.to
+	}
+	return Period{from: from, to: to}, true
+}
+
+// Gap returns days between periods, false when periods overlap or are adjacent
+func (p Period) Gap(other Period) (Period, bool) {
+	if p.Overlaps(other) || p.IsAdjacent(other) {
+		return Period{from: NullLocalDate, to: NullLocalDate}, false
+	}
+	earlier, later := p, other
+	if later.endsBefore(earlier.from) {
+		earlier, later = later, earlier
+	}
+	return Period{from: earlier.to.Next(), to: later.from.AddDate(0, 0, -1)}, true
+}
+
+// Subtract returns days of period which are not in other period, as zero, one or two periods in order
+func (p Period) Subtract(other Period) []Period {
+	if !p.Overlaps(other) {
+		return []Period{p}
+	}
+	var result []Period
+	if other.from.After(p.from) {
+		result = append(result, Period{from: p.from, to: other.from.AddDate(0, 0, -1)})
+	}
+	if !other.IsOpen() && (p.IsOpen() || p.to.After(other.to)) {
+		result = append(result, Period{from: other.to.Next(), to: p.to})
+	}
+	return result
+}
+
+// Until returns period ending on horizon, or earlier when period ends before it,
+// e.g. to bound an open period before splitting it
+func (p Period) Until(horizon LocalDate) (Period, error) {
+	if !p.endsBefore(horizon) {
+		return NewPeriod(p.from, horizon)
+	}
+	return p, nil
+}
+
+// endsBefore tells if period ends before given date, open period never does
+func (p Period) endsBefore(date LocalDate) bool {
+	return !p.IsOpen() && date.After(p.to)
+}
+
 // From returns first day of a period
 func (p Period) From() LocalDate {
 	return p.from
@@ -113,3 +208,137 @@ func (p Period) ToDateTimeSpan() DateTimeSpan {
 	}
 	return NewDateTimeSpan(p.from.Start(), p.to.End())
 }
+
+// intervalSeparator separates start and end in text form of Period and DateTimeSpan,
+// as in ISO 8601 time intervals, e.g. "2018-01-01/2018-01-31"
+const intervalSeparator = "/"
+
+// openIntervalEnd is used in text form in place of the end of an open interval,
+// e.g. "2018-01-01/.."
+const openIntervalEnd = ".."
+
+// ParsePeriod parses string in form of "2006-01-02/2006-01-02" or "2006-01-02/.." into Period
+func ParsePeriod(value string) (Period, error) {
+	parts := strings.Split(value, intervalSeparator)
+	if len(parts) != 2 {
+		return Period{from: NullLocalDate, to: NullLocalDate}, fmt.Errorf("Wrong Period format: %v", value)
+	}
+	from, err := ParseLocalDate(parts[0])
+	if err != nil {
+		return Period{from: NullLocalDate, to: NullLocalDate}, err
+	}
+	if parts[1] == openIntervalEnd {
+		return NewOpenPeriodFrom(from)
+	}
+	to, err := ParseLocalDate(parts[1])
+	if err != nil {
+		return Period{from: NullLocalDate, to: NullLocalDate}, err
+	}
+	return NewPeriod(from, to)
+}
+
+// MustParsePeriod is like ParsePeriod but panics on error
+func MustParsePeriod(value string) Period {
+	period, err := ParsePeriod(value)
+	if err != nil {
+		panic(err)
+	}
+	return period
+}
+
+// MarshalText serializes period to string in form of "2006-01-02/2006-01-02" or "2006-01-02/.."
+func (p Period) MarshalText() ([]byte, error) {
+	to := openIntervalEnd
+	if !p.IsOpen() {
+		to = p.to.String()
+	}
+	return []byte(p.from.String() + intervalSeparator + to), nil
+}
+
+// UnmarshalText parses string into period using ParsePeriod
+func (p *Period) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	period, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = period
+	return nil
+}
+
+type jsonPeriod struct {
+	From *LocalDate `json:"from"`
+	To   *LocalDate `json:"to"`
+}
+
+// MarshalJSON marshals period to JSON object, "to" of an open period is null
+func (p Period) MarshalJSON() ([]byte, error) {
+	jp := jsonPeriod{From: &p.from}
+	if !p.IsOpen() {
+		jp.To = &p.to
+	}
+	return json.Marshal(jp)
+}
+
+// UnmarshalJSON parses JSON object into period, null "to" means an open period
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var jp jsonPeriod
+	if err := json.Unmarshal(data, &jp); err != nil {
+		return err
+	}
+	if jp.From == nil {
+		return ErrPeriodInvalidParamNull
+	}
+	var period Period
+	var err error
+	if jp.To == nil {
+		period, err = NewOpenPeriodFrom(*jp.From)
+	} else {
+		period, err = NewPeriod(*jp.From, *jp.To)
+	}
+	if err != nil {
+		return err
+	}
+	*p = period
+	return nil
+}
+
+// Scan implements the Scanner interface.
+// It accepts PostgreSQL daterange literals, e.g. "[2018-01-01,2018-02-01)",
+// as well as text form of Period, e.g. "2018-01-01/2018-01-31".
+func (p *Period) Scan(value interface{}) error {
+	text, err := scanText("Period", value)
+	if err != nil {
+		return err
+	}
+	var period Period
+	if isPgRange(text) {
+		r, err := parsePgRange(text)
+		if err != nil {
+			return err
+		}
+		period, err = periodFromPgRange(r)
+		if err != nil {
+			return err
+		}
+	} else {
+		period, err = ParsePeriod(text)
+		if err != nil {
+			return err
+		}
+	}
+	*p = period
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+// Period is stored as PostgreSQL daterange literal, e.g. "[2018-01-01,2018-02-01)",
+// open period has unbounded upper bound, e.g. "[2018-01-01,)".
+func (p Period) Value() (driver.Value, error) {
+	return p.pgRange().String(), nil
+}
@@ -0,0 +1,204 @@
+package time
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// PeriodSet is a set of days kept as sorted periods, which neither overlap nor are adjacent,
+// e.g. adding [2018-01-01 - 2018-01-10] and [2018-01-11 - 2018-01-20] gives [2018-01-01 - 2018-01-20].
+// PeriodSet is immutable, operations return new sets. Zero value is an empty set.
+type PeriodSet struct {
+	periods []Period
+}
+
+// NewPeriodSet creates PeriodSet of days of given periods, which may overlap and come in any order
+func NewPeriodSet(periods ...Period) PeriodSet {
+	return PeriodSet{}.Add(periods...)
+}
+
+// Periods returns periods of the set in order
+func (s PeriodSet) Periods() []Period {
+	return append(make([]Period, 0, len(s.periods)), s.periods...)
+}
+
+// Each calls fn for periods of the set in order, until fn returns false
+func (s PeriodSet) Each(fn func(Period) bool) {
+	for _, period := range s.periods {
+		if !fn(period) {
+			return
+		}
+	}
+}
+
+// Len returns the number of periods in the set
+func (s PeriodSet) Len() int {
+	return len(s.periods)
+}
+
+// IsEmpty tells if set contains no days
+func (s PeriodSet) IsEmpty() bool {
+	return len(s.periods) == 0
+}
+
+// IsOpen tells if set contains an open period
+func (s PeriodSet) IsOpen() bool {
+	return len(s.periods) > 0 && s.periods[len(s.periods)-1].IsOpen()
+}
+
+// DayCount returns the number of days in the set, false when the set is open
+func (s PeriodSet) DayCount() (int, bool) {
+	if s.IsOpen() {
+		return 0, false
+	}
+	count := 0
+	for _, period := range s.periods {
+		count += daysBetween(period.from, period.to) + 1
+	}
+	return count, true
+}
+
+// Contains tells if set contains given date
+func (s PeriodSet) Contains(date LocalDate) bool {
+	i := s.search(date)
+	return i < len(s.periods) && s.periods[i].Contains(date)
+}
+
+// Encloses tells if set contains all days of period
+func (s PeriodSet) Encloses(period Period) bool {
+	i := s.search(period.from)
+	return i < len(s.periods) && s.periods[i].Encloses(period)
+}
+
+// Overlaps tells if set contains any day of period
+func (s PeriodSet) Overlaps(period Period) bool {
+	i := s.search(period.from)
+	return i < len(s.periods) && s.periods[i].Overlaps(period)
+}
+
+// search returns index of the first period which doesn't end before date
+func (s PeriodSet) search(date LocalDate) int {
+	return sort.Search(len(s.periods), func(i int) bool {
+		return !s.periods[i].endsBefore(date)
+	})
+}
+
+// Add returns set with days of given periods added
+func (s PeriodSet) Add(periods ...Period) PeriodSet {
+	if len(periods) == 0 {
+		return s
+	}
+	all := make([]Period, 0, len(s.periods)+len(periods))
+	all = append(append(all, s.periods...), periods...)
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[j].from.After(all[i].from)
+	})
+	var result []Period
+	for _, period := range all {
+		if last := len(result) - 1; last >= 0 {
+			if union, ok := result[last].Union(period); ok {
+				result[last] = union
+				continue
+			}
+		}
+		result = append(result, period)
+	}
+	return PeriodSet{periods: result}
+}
+
+// Remove returns set without days of given periods
+func (s PeriodSet) Remove(periods ...Period) PeriodSet {
+	return s.Difference(NewPeriodSet(periods...))
+}
+
+// Union returns set of days which are in any of sets
+func (s PeriodSet) Union(other PeriodSet) PeriodSet {
+	return s.Add(other.periods...)
+}
+
+// Intersection returns set of days which are in both sets
+func (s PeriodSet) Intersection(other PeriodSet) PeriodSet {
+	var result []Period
+	for i, j := 0, 0; i < len(s.periods) && j < len(other.periods); {
+		first, second := s.periods[i], other.periods[j]
+		if intersection, ok := first.Intersection(second); ok {
+			result = append(result, intersection)
+		}
+		// the period which ends first can't overlap any further period of the other set
+		if first.IsOpen() || !second.IsOpen() && first.to.After(second.to) {
+			j++
+		} else {
+			i++
+		}
+	}
+	return PeriodSet{periods: result}
+}
+
+// Difference returns set of days which are in this set but not in the other set
+func (s PeriodSet) Difference(other PeriodSet) PeriodSet {
+	var result []Period
+	j := 0
+	for _, period := range s.periods {
+		rest, remains := period, true
+		for remains && j < len(other.periods) {
+			removed := other.periods[j]
+			if rest.endsBefore(removed.from) {
+				break
+			}
+			if removed.endsBefore(rest.from) {
+				j++
+				continue
+			}
+			remains = false
+			for _, piece := range rest.Subtract(removed) {
+				if piece.from.After(removed.from) {
+					rest, remains = piece, true
+				} else {
+					result = append(result, piece)
+				}
+			}
+			// removed period ends within this one, so it can't overlap any further period
+			if remains {
+				j++
+			}
+		}
+		if remains {
+			result = append(result, rest)
+		}
+	}
+	return PeriodSet{periods: result}
+}
+
+// Clip returns set of days of this set which are in bounding period
+func (s PeriodSet) Clip(bounding Period) PeriodSet {
+	return s.Intersection(NewPeriodSet(bounding))
+}
+
+// Complement returns set of days of within period which are not in this set
+func (s PeriodSet) Complement(within Period) PeriodSet {
+	return NewPeriodSet(within).Difference(s)
+}
+
+func (s PeriodSet) String() string {
+	parts := make([]string, len(s.periods))
+	for i, period := range s.periods {
+		parts[i] = period.String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// MarshalJSON marshals set to JSON array of periods
+func (s PeriodSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Periods())
+}
+
+// UnmarshalJSON parses JSON array of periods into set, periods are normalized as in NewPeriodSet
+func (s *PeriodSet) UnmarshalJSON(data []byte) error {
+	var periods []Period
+	if err := json.Unmarshal(data, &periods); err != nil {
+		return err
+	}
+	*s = NewPeriodSet(periods...)
+	return nil
+}
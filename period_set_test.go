@@ -0,0 +1,112 @@
+package time
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func parsePeriods(periods ...string) []Period {
+	var parsed []Period
+	for _, period := range periods {
+		parsed = append(parsed, MustParsePeriod(period))
+	}
+	return parsed
+}
+
+func periodSet(periods ...string) PeriodSet {
+	return NewPeriodSet(parsePeriods(periods...)...)
+}
+
+func TestNewPeriodSetNormalizes(t *testing.T) {
+	set := periodSet("2018-03-01/2018-03-10", "2018-01-01/2018-01-10", "2018-01-11/2018-01-20",
+		"2018-01-05/2018-01-07", "2018-03-05/2018-03-20", "2018-05-01/..", "2018-06-01/2018-06-02")
+	assert.Equal(t, []Period{
+		MustParsePeriod("2018-01-01/2018-01-20"),
+		MustParsePeriod("2018-03-01/2018-03-20"),
+		MustParsePeriod("2018-05-01/.."),
+	}, set.Periods())
+	assert.Equal(t, 3, set.Len())
+	assert.True(t, set.IsOpen())
+	assert.Equal(t, "{[2018-01-01 - 2018-01-20], [2018-03-01 - 2018-03-20], [2018-05-01 - indefinitely]}", set.String())
+
+	assert.True(t, PeriodSet{}.IsEmpty())
+	assert.Equal(t, []Period{}, PeriodSet{}.Periods())
+}
+
+func TestPeriodSetLookups(t *testing.T) {
+	set := periodSet("2018-01-01/2018-01-20", "2018-03-01/2018-03-20", "2018-05-01/..")
+	for date, expected := range map[string]bool{
+		"2017-12-31": false,
+		"2018-01-01": true,
+		"2018-01-20": true,
+		"2018-01-21": false,
+		"2018-03-10": true,
+		"2018-04-30": false,
+		"2018-05-01": true,
+		"2030-01-01": true,
+	} {
+		assert.Equal(t, expected, set.Contains(MustParseLocalDate(date)), date)
+	}
+	assert.True(t, set.Encloses(MustParsePeriod("2018-03-02/2018-03-20")))
+	assert.True(t, set.Encloses(MustParsePeriod("2019-01-01/..")))
+	assert.False(t, set.Encloses(MustParsePeriod("2018-01-15/2018-03-05")))
+	assert.True(t, set.Overlaps(MustParsePeriod("2018-01-15/2018-03-05")))
+	assert.False(t, set.Overlaps(MustParsePeriod("2018-03-21/2018-04-30")))
+	assert.False(t, PeriodSet{}.Contains(MustParseLocalDate("2018-01-01")))
+}
+
+func TestPeriodSetDayCount(t *testing.T) {
+	count, ok := periodSet("2018-01-01/2018-01-20", "2018-02-01/2018-03-01").DayCount()
+	assert.True(t, ok)
+	assert.Equal(t, 49, count)
+	_, ok = periodSet("2018-01-01/..").DayCount()
+	assert.False(t, ok)
+}
+
+func TestPeriodSetOperations(t *testing.T) {
+	set := periodSet("2018-01-01/2018-01-20", "2018-03-01/2018-03-20", "2018-05-01/..")
+	other := periodSet("2018-01-10/2018-03-05", "2018-03-10/2018-03-11", "2018-04-01/2018-05-10", "2018-06-01/2018-06-30")
+
+	assert.Equal(t, periodSet("2018-01-01/2018-03-20", "2018-04-01/.."), set.Union(other))
+	assert.Equal(t, periodSet("2018-01-10/2018-01-20", "2018-03-01/2018-03-05", "2018-03-10/2018-03-11",
+		"2018-05-01/2018-05-10", "2018-06-01/2018-06-30"), set.Intersection(other))
+	assert.Equal(t, set.Intersection(other), other.Intersection(set))
+	assert.Equal(t, periodSet("2018-01-01/2018-01-09", "2018-03-06/2018-03-09", "2018-03-12/2018-03-20",
+		"2018-05-11/2018-05-31", "2018-07-01/.."), set.Difference(other))
+	assert.Equal(t, periodSet("2018-01-21/2018-02-28", "2018-04-01/2018-04-30"), other.Difference(set))
+	assert.Equal(t, PeriodSet{}, set.Difference(set))
+
+	assert.Equal(t, periodSet("2017-12-01/2017-12-31", "2018-01-21/2018-02-28", "2018-03-21/2018-04-30"),
+		set.Complement(MustParsePeriod("2017-12-01/..")))
+	assert.Equal(t, periodSet("2018-01-15/2018-01-20", "2018-03-01/2018-03-20", "2018-05-01/2018-05-05"),
+		set.Clip(MustParsePeriod("2018-01-15/2018-05-05")))
+	assert.Equal(t, periodSet("2018-01-01/2018-01-04", "2018-01-16/2018-01-20", "2018-03-01/2018-03-20"),
+		set.Remove(MustParsePeriod("2018-01-05/2018-01-15"), MustParsePeriod("2018-04-01/..")))
+}
+
+func TestPeriodSetEach(t *testing.T) {
+	set := periodSet("2018-01-01/2018-01-20", "2018-03-01/2018-03-20", "2018-05-01/..")
+	var visited []Period
+	set.Each(func(period Period) bool {
+		visited = append(visited, period)
+		return len(visited) < 2
+	})
+	assert.Equal(t, set.Periods()[:2], visited)
+}
+
+func TestPeriodSetJSON(t *testing.T) {
+	set := periodSet("2018-01-01/2018-01-20", "2018-05-01/..")
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"from":"2018-01-01","to":"2018-01-20"},{"from":"2018-05-01","to":null}]`, string(data))
+
+	var parsed PeriodSet
+	assert.NoError(t, json.Unmarshal([]byte(`[{"from":"2018-05-01","to":null},{"from":"2018-01-01","to":"2018-01-20"},{"from":"2018-01-21","to":"2018-01-30"}]`), &parsed))
+	assert.Equal(t, periodSet("2018-01-01/2018-01-30", "2018-05-01/.."), parsed)
+
+	data, err = json.Marshal(PeriodSet{})
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+}
@@ -16,3 +16,158 @@ func TestPeriodString(t *testing.T) {
 	period, _ = NewOneDayPeriod(NewLocalDate(2010, 11, 12))
 	assert.Equal(t, "[2010-11-12 - 2010-11-12]", period.String())
 }
+
+func TestPeriodMarshalJSON(t *testing.T) {
+	JSON, err := MustNewPeriod(NewLocalDate(2018, 1, 1), NewLocalDate(2018, 1, 31)).MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"from":"2018-01-01","to":"2018-01-31"}`, string(JSON))
+
+	JSON, err = MustNewOpenPeriodFrom(NewLocalDate(2018, 1, 1)).MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"from":"2018-01-01","to":null}`, string(JSON))
+}
+
+func TestPeriodUnmarshalJSON(t *testing.T) {
+	var period Period
+	assert.NoError(t, period.UnmarshalJSON([]byte(`{"from":"2018-01-01","to":"2018-01-31"}`)))
+	assert.Equal(t, MustNewPeriod(NewLocalDate(2018, 1, 1), NewLocalDate(2018, 1, 31)), period)
+
+	assert.NoError(t, period.UnmarshalJSON([]byte(`{"from":"2018-01-01","to":null}`)))
+	assert.Equal(t, MustNewOpenPeriodFrom(NewLocalDate(2018, 1, 1)), period)
+
+	assert.NoError(t, period.UnmarshalJSON([]byte(`{"from":"2018-01-01"}`)))
+	assert.True(t, period.IsOpen())
+
+	assert.Equal(t, ErrPeriodInvalidFromAfterTo,
+		period.UnmarshalJSON([]byte(`{"from":"2018-02-01","to":"2018-01-31"}`)))
+	assert.Equal(t, ErrPeriodInvalidParamNull, period.UnmarshalJSON([]byte(`{"to":"2018-01-31"}`)))
+	assert.Error(t, period.UnmarshalJSON([]byte(`{"from":"2018-13-01"}`)))
+}
+
+func TestPeriodText(t *testing.T) {
+	text, _ := MustNewPeriod(NewLocalDate(2018, 1, 1), NewLocalDate(2018, 1, 31)).MarshalText()
+	assert.Equal(t, "2018-01-01/2018-01-31", string(text))
+	text, _ = MustNewOpenPeriodFrom(NewLocalDate(2018, 1, 1)).MarshalText()
+	assert.Equal(t, "2018-01-01/..", string(text))
+
+	var period Period
+	assert.NoError(t, period.UnmarshalText([]byte("2018-01-01/2018-01-31")))
+	assert.Equal(t, MustNewPeriod(NewLocalDate(2018, 1, 1), NewLocalDate(2018, 1, 31)), period)
+	assert.NoError(t, period.UnmarshalText([]byte("2018-01-01/..")))
+	assert.Equal(t, MustNewOpenPeriodFrom(NewLocalDate(2018, 1, 1)), period)
+
+	assert.Error(t, period.UnmarshalText([]byte("2018-01-01")))
+	assert.Error(t, period.UnmarshalText([]byte("2018-01-31/2018-01-01")))
+}
+
+func TestPeriodScanAndValue(t *testing.T) {
+	var period Period
+	assert.NoError(t, period.Scan([]byte("2018-01-01/..")))
+	assert.Equal(t, MustNewOpenPeriodFrom(NewLocalDate(2018, 1, 1)), period)
+	assert.NoError(t, period.Scan("2018-01-01/2018-01-31"))
+	assert.Equal(t, MustNewPeriod(NewLocalDate(2018, 1, 1), NewLocalDate(2018, 1, 31)), period)
+	assert.Error(t, period.Scan(nil))
+	assert.Error(t, period.Scan(42))
+
+	value, err := period.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "[2018-01-01,2018-02-01)", value)
+}
+
+func TestPeriodOverlapsAndAdjacency(t *testing.T) {
+	january := MustParsePeriod("2018-01-01/2018-01-31")
+	for _, tc := range []struct {
+		other    string
+		overlaps bool
+		adjacent bool
+		encloses bool
+	}{
+		{"2018-01-10/2018-01-20", true, false, true},
+		{"2018-01-01/2018-01-31", true, false, true},
+		{"2017-12-01/2018-01-01", true, false, false},
+		{"2018-01-31/..", true, false, false},
+		{"2018-02-01/2018-02-28", false, true, false},
+		{"2018-02-01/..", false, true, false},
+		{"2017-12-01/2017-12-31", false, true, false},
+		{"2017-12-01/2017-12-30", false, false, false},
+		{"2018-02-02/..", false, false, false},
+	} {
+		other := MustParsePeriod(tc.other)
+		assert.Equal(t, tc.overlaps, january.Overlaps(other), tc.other)
+		assert.Equal(t, tc.overlaps, other.Overlaps(january), tc.other)
+		assert.Equal(t, tc.adjacent, january.IsAdjacent(other), tc.other)
+		assert.Equal(t, tc.adjacent, other.IsAdjacent(january), tc.other)
+		assert.Equal(t, tc.encloses, january.Encloses(other), tc.other)
+	}
+
+	open := MustParsePeriod("2018-01-01/..")
+	assert.True(t, open.Encloses(MustParsePeriod("2018-03-01/..")))
+	assert.True(t, open.Encloses(january))
+	assert.False(t, january.Encloses(open))
+	assert.True(t, open.Overlaps(MustParsePeriod("2017-01-01/..")))
+}
+
+func TestPeriodIntersectionAndUnion(t *testing.T) {
+	for _, tc := range []struct {
+		first, second       string
+		intersection, union string
+	}{
+		{"2018-01-01/2018-01-31", "2018-01-15/2018-02-15", "2018-01-15/2018-01-31", "2018-01-01/2018-02-15"},
+		{"2018-01-01/2018-01-31", "2018-01-10/2018-01-20", "2018-01-10/2018-01-20", "2018-01-01/2018-01-31"},
+		{"2018-01-01/2018-01-31", "2018-01-15/..", "2018-01-15/2018-01-31", "2018-01-01/.."},
+		{"2018-01-01/..", "2017-01-15/..", "2018-01-01/..", "2017-01-15/.."},
+		{"2018-01-01/2018-01-31", "2018-02-01/..", "", "2018-01-01/.."},
+		{"2018-01-01/2018-01-31", "2018-02-02/2018-02-05", "", ""},
+	} {
+		first, second := MustParsePeriod(tc.first), MustParsePeriod(tc.second)
+		for _, pair := range [][2]Period{{first, second}, {second, first}} {
+			intersection, ok := pair[0].Intersection(pair[1])
+			assert.Equal(t, tc.intersection != "", ok)
+			if ok {
+				assert.Equal(t, MustParsePeriod(tc.intersection), intersection)
+			}
+			union, ok := pair[0].Union(pair[1])
+			assert.Equal(t, tc.union != "", ok)
+			if ok {
+				assert.Equal(t, MustParsePeriod(tc.union), union)
+			}
+		}
+	}
+}
+
+func TestPeriodGap(t *testing.T) {
+	january := MustParsePeriod("2018-01-01/2018-01-31")
+	gap, ok := january.Gap(MustParsePeriod("2018-02-05/.."))
+	assert.True(t, ok)
+	assert.Equal(t, MustParsePeriod("2018-02-01/2018-02-04"), gap)
+	gap, ok = MustParsePeriod("2018-02-03/2018-02-10").Gap(january)
+	assert.True(t, ok)
+	assert.Equal(t, MustParsePeriod("2018-02-01/2018-02-02"), gap)
+
+	_, ok = january.Gap(MustParsePeriod("2018-02-01/2018-02-10"))
+	assert.False(t, ok)
+	_, ok = january.Gap(MustParsePeriod("2018-01-31/.."))
+	assert.False(t, ok)
+}
+
+func TestPeriodSubtract(t *testing.T) {
+	for _, tc := range []struct {
+		period, other string
+		expected      []string
+	}{
+		{"2018-01-01/2018-01-31", "2018-02-01/..", []string{"2018-01-01/2018-01-31"}},
+		{"2018-01-01/2018-01-31", "2018-01-10/2018-01-20", []string{"2018-01-01/2018-01-09", "2018-01-21/2018-01-31"}},
+		{"2018-01-01/2018-01-31", "2018-01-10/..", []string{"2018-01-01/2018-01-09"}},
+		{"2018-01-01/2018-01-31", "2017-01-10/2018-01-30", []string{"2018-01-31/2018-01-31"}},
+		{"2018-01-01/2018-01-31", "2017-01-01/2018-01-31", nil},
+		{"2018-01-01/..", "2018-01-10/2018-01-20", []string{"2018-01-01/2018-01-09", "2018-01-21/.."}},
+		{"2018-01-01/..", "2017-01-10/2018-01-20", []string{"2018-01-21/.."}},
+		{"2018-01-01/..", "2017-01-10/..", nil},
+	} {
+		var expected []Period
+		for _, e := range tc.expected {
+			expected = append(expected, MustParsePeriod(e))
+		}
+		assert.Equal(t, expected, MustParsePeriod(tc.period).Subtract(MustParsePeriod(tc.other)), tc.period+" - "+tc.other)
+	}
+}
@@ -0,0 +1,134 @@
+package time
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pgInterval is a parsed PostgreSQL interval, which consists of
+// calendar amount and time of fixed length
+type pgInterval struct {
+	amount CalendarAmount
+	time   Duration
+}
+
+// parsePgInterval parses PostgreSQL interval output in postgres style, e.g. "1 year 2 mons 3 days 04:05:06",
+// postgres_verbose style, e.g. "@ 1 year 2 mons 4 hours" and in ISO 8601, e.g. "P1Y2M3DT4H5M6S"
+func parsePgInterval(value string) (pgInterval, error) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "P") || strings.HasPrefix(value, "-P") {
+		return parseISOInterval(value)
+	}
+
+	var interval pgInterval
+	wrongFormat := fmt.Errorf("Wrong interval format: %v", value)
+	fields := strings.Fields(strings.TrimPrefix(value, "@"))
+	ago := len(fields) > 0 && fields[len(fields)-1] == "ago"
+	if ago {
+		fields = fields[:len(fields)-1]
+	}
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		if strings.Contains(field, ":") {
+			clock, err := parsePgIntervalClock(field)
+			if err != nil {
+				return pgInterval{}, wrongFormat
+			}
+			interval.time += clock
+			continue
+		}
+		if i+1 == len(fields) {
+			return pgInterval{}, wrongFormat
+		}
+		unit := fields[i+1]
+		i++
+		if strings.HasPrefix(unit, "sec") {
+			seconds, err := parseISOSeconds(field)
+			if err != nil {
+				return pgInterval{}, wrongFormat
+			}
+			interval.time += seconds
+			continue
+		}
+		number, err := strconv.Atoi(field)
+		if err != nil {
+			return pgInterval{}, wrongFormat
+		}
+		switch {
+		case strings.HasPrefix(unit, "year"):
+			interval.amount.years += number
+		case strings.HasPrefix(unit, "mon"):
+			interval.amount.months += number
+		case strings.HasPrefix(unit, "day"):
+			interval.amount.days += number
+		case strings.HasPrefix(unit, "hour"):
+			interval.time += Duration(number) * Hour
+		case strings.HasPrefix(unit, "min"):
+			interval.time += Duration(number) * Minute
+		default:
+			return pgInterval{}, wrongFormat
+		}
+	}
+	if ago {
+		return pgInterval{amount: interval.amount.Negated(), time: -interval.time}, nil
+	}
+	return interval, nil
+}
+
+// parseISOInterval parses ISO 8601 duration with calendar and time part, e.g. "P1Y2M3DT4H5M6S"
+func parseISOInterval(value string) (pgInterval, error) {
+	datePart, timePart := value, ""
+	if t := strings.IndexByte(value, 'T'); t >= 0 {
+		datePart, timePart = value[:t], value[t:]
+	}
+	var interval pgInterval
+	if strings.TrimPrefix(datePart, "-") != "P" {
+		amount, err := ParseCalendarAmount(datePart)
+		if err != nil {
+			return pgInterval{}, err
+		}
+		interval.amount = amount
+	} else if timePart == "" {
+		return pgInterval{}, fmt.Errorf("Wrong interval format: %v", value)
+	}
+	if timePart != "" {
+		sign := ""
+		if strings.HasPrefix(datePart, "-") {
+			sign = "-"
+		}
+		d, err := parseISODuration(sign + "P" + timePart)
+		if err != nil {
+			return pgInterval{}, err
+		}
+		interval.time = d
+	}
+	return interval, nil
+}
+
+// parsePgIntervalClock parses time part of interval, e.g. "-36:05:06.5"
+func parsePgIntervalClock(value string) (Duration, error) {
+	sign := Duration(1)
+	if strings.HasPrefix(value, "-") {
+		sign = -1
+	}
+	parts := strings.Split(strings.TrimLeft(value, "+-"), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("Wrong interval time format: %v", value)
+	}
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	minutes, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	var seconds Duration
+	if len(parts) == 3 {
+		if seconds, err = parseISOSeconds(parts[2]); err != nil {
+			return 0, err
+		}
+	}
+	return sign * (Duration(hours)*Hour + Duration(minutes)*Minute + seconds), nil
+}
@@ -0,0 +1,339 @@
+package time
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// pgTimestampFormat is used to render and parse PostgreSQL timestamp values
+const pgTimestampFormat = "2006-01-02 15:04:05.999999999"
+
+// pgTimestampResolution is the smallest difference between two PostgreSQL timestamps.
+// It is used to convert inclusive upper and exclusive lower bounds of tsrange
+// into half-open DateTimeSpan.
+const pgTimestampResolution = Microsecond
+
+const pgEmptyRange = "empty"
+
+const pgInfinity = "infinity"
+
+// pgRange is a parsed PostgreSQL range literal, e.g. "[2018-01-01,2018-02-01)"
+type pgRange struct {
+	lower          string
+	upper          string
+	lowerInclusive bool
+	upperInclusive bool
+	lowerUnbounded bool
+	upperUnbounded bool
+	empty          bool
+}
+
+// parsePgRange parses PostgreSQL range literal
+func parsePgRange(value string) (pgRange, error) {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, pgEmptyRange) {
+		return pgRange{empty: true}, nil
+	}
+	if len(value) < 3 {
+		return pgRange{}, fmt.Errorf("Wrong range format: %v", value)
+	}
+
+	var r pgRange
+	switch value[0] {
+	case '[':
+		r.lowerInclusive = true
+	case '(':
+	default:
+		return pgRange{}, fmt.Errorf("Wrong range format: %v", value)
+	}
+	switch value[len(value)-1] {
+	case ']':
+		r.upperInclusive = true
+	case ')':
+	default:
+		return pgRange{}, fmt.Errorf("Wrong range format: %v", value)
+	}
+
+	bounds, err := splitPgList(value[1:len(value)-1], ',')
+	if err != nil {
+		return pgRange{}, err
+	}
+	if len(bounds) != 2 {
+		return pgRange{}, fmt.Errorf("Wrong range format: %v", value)
+	}
+	r.lower, r.lowerUnbounded = unquotePgRangeBound(bounds[0])
+	r.upper, r.upperUnbounded = unquotePgRangeBound(bounds[1])
+	return r, nil
+}
+
+func (r pgRange) String() string {
+	if r.empty {
+		return pgEmptyRange
+	}
+	var sb strings.Builder
+	if r.lowerInclusive && !r.lowerUnbounded {
+		sb.WriteByte('[')
+	} else {
+		sb.WriteByte('(')
+	}
+	if !r.lowerUnbounded {
+		sb.WriteString(quotePgRangeBound(r.lower))
+	}
+	sb.WriteByte(',')
+	if !r.upperUnbounded {
+		sb.WriteString(quotePgRangeBound(r.upper))
+	}
+	if r.upperInclusive && !r.upperUnbounded {
+		sb.WriteByte(']')
+	} else {
+		sb.WriteByte(')')
+	}
+	return sb.String()
+}
+
+// unquotePgRangeBound returns bound value and reports whether the bound is missing or infinite
+func unquotePgRangeBound(bound string) (string, bool) {
+	if bound == "" {
+		return "", true
+	}
+	if len(bound) >= 2 && bound[0] == '"' && bound[len(bound)-1] == '"' {
+		bound = strings.NewReplacer(`\"`, `"`, `\\`, `\`, `""`, `"`).Replace(bound[1 : len(bound)-1])
+	}
+	if strings.EqualFold(bound, pgInfinity) || strings.EqualFold(bound, "-"+pgInfinity) {
+		return "", true
+	}
+	return bound, false
+}
+
+func quotePgRangeBound(bound string) string {
+	if strings.ContainsAny(bound, ` ,()[]"\`) {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(bound) + `"`
+	}
+	return bound
+}
+
+// splitPgList splits value on separators that are not enclosed in quotes or brackets
+func splitPgList(value string, separator byte) ([]string, error) {
+	var parts []string
+	depth := 0
+	quoted := false
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case c == '\\' && quoted:
+			i++
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+		case c == separator && depth == 0:
+			parts = append(parts, strings.TrimSpace(value[start:i]))
+			start = i + 1
+		}
+	}
+	if quoted || depth != 0 {
+		return nil, fmt.Errorf("Wrong range format: %v", value)
+	}
+	return append(parts, strings.TrimSpace(value[start:])), nil
+}
+
+// parsePgMultirange parses PostgreSQL multirange literal, e.g. "{[2018-01-01,2018-02-01)}"
+func parsePgMultirange(value string) ([]pgRange, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 2 || value[0] != '{' || value[len(value)-1] != '}' {
+		return nil, fmt.Errorf("Wrong multirange format: %v", value)
+	}
+	value = strings.TrimSpace(value[1 : len(value)-1])
+	if value == "" {
+		return nil, nil
+	}
+	literals, err := splitPgList(value, ',')
+	if err != nil {
+		return nil, err
+	}
+	ranges := make([]pgRange, 0, len(literals))
+	for _, literal := range literals {
+		r, err := parsePgRange(literal)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+func formatPgMultirange(ranges []pgRange) string {
+	literals := make([]string, len(ranges))
+	for i, r := range ranges {
+		literals[i] = r.String()
+	}
+	return "{" + strings.Join(literals, ",") + "}"
+}
+
+func isPgRange(value string) bool {
+	value = strings.TrimSpace(value)
+	return strings.HasPrefix(value, "[") || strings.HasPrefix(value, "(") || strings.EqualFold(value, pgEmptyRange)
+}
+
+// pgRange converts period into PostgreSQL daterange, which is canonically
+// inclusive on the lower and exclusive on the upper bound
+func (p Period) pgRange() pgRange {
+	r := pgRange{lower: p.from.String(), lowerInclusive: true}
+	if p.IsOpen() {
+		r.upperUnbounded = true
+	} else {
+		r.upper = p.to.Next().String()
+	}
+	return r
+}
+
+func periodFromPgRange(r pgRange) (Period, error) {
+	nullPeriod := Period{from: NullLocalDate, to: NullLocalDate}
+	if r.empty {
+		return nullPeriod, fmt.Errorf("Period can't be created from empty daterange")
+	}
+	if r.lowerUnbounded {
+		return nullPeriod, ErrPeriodInvalidParamNull
+	}
+	from, err := ParseLocalDate(r.lower)
+	if err != nil {
+		return nullPeriod, err
+	}
+	if !r.lowerInclusive {
+		from = from.Next()
+	}
+	if r.upperUnbounded {
+		return NewOpenPeriodFrom(from)
+	}
+	to, err := ParseLocalDate(r.upper)
+	if err != nil {
+		return nullPeriod, err
+	}
+	if !r.upperInclusive {
+		to = to.AddDate(0, 0, -1)
+	}
+	return NewPeriod(from, to)
+}
+
+// pgRange converts span into PostgreSQL tsrange
+func (ts DateTimeSpan) pgRange() pgRange {
+	r := pgRange{lowerInclusive: true}
+	if ts.from.IsNull() {
+		r.lowerUnbounded = true
+	} else {
+		r.lower = ts.from.t.Format(pgTimestampFormat)
+	}
+	if ts.to.IsNull() {
+		r.upperUnbounded = true
+	} else {
+		r.upper = ts.to.t.Format(pgTimestampFormat)
+	}
+	return r
+}
+
+func dateTimeSpanFromPgRange(r pgRange) (DateTimeSpan, error) {
+	nullSpan := DateTimeSpan{from: NullLocalDateTime, to: NullLocalDateTime}
+	if r.empty {
+		return nullSpan, fmt.Errorf("DateTimeSpan can't be created from empty tsrange")
+	}
+	var from, to LocalDateTime
+	var err error
+	if !r.lowerUnbounded {
+		if from, err = parsePgTimestamp(r.lower); err != nil {
+			return nullSpan, err
+		}
+		if !r.lowerInclusive {
+			from = from.Add(pgTimestampResolution)
+		}
+	}
+	if !r.upperUnbounded {
+		if to, err = parsePgTimestamp(r.upper); err != nil {
+			return nullSpan, err
+		}
+		if r.upperInclusive {
+			to = to.Add(pgTimestampResolution)
+		}
+	}
+	return newValidOpenDateTimeSpan(from, to)
+}
+
+func parsePgTimestamp(value string) (LocalDateTime, error) {
+	t, err := time.Parse(pgTimestampFormat, value)
+	if err != nil {
+		return NullLocalDateTime, err
+	}
+	return NewLocalDateTime(t), nil
+}
+
+// PeriodMultirange is a list of periods stored in PostgreSQL datemultirange column
+type PeriodMultirange []Period
+
+// Scan implements the Scanner interface.
+func (pm *PeriodMultirange) Scan(value interface{}) error {
+	text, err := scanText("PeriodMultirange", value)
+	if err != nil {
+		return err
+	}
+	ranges, err := parsePgMultirange(text)
+	if err != nil {
+		return err
+	}
+	periods := make(PeriodMultirange, 0, len(ranges))
+	for _, r := range ranges {
+		period, err := periodFromPgRange(r)
+		if err != nil {
+			return err
+		}
+		periods = append(periods, period)
+	}
+	*pm = periods
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+func (pm PeriodMultirange) Value() (driver.Value, error) {
+	ranges := make([]pgRange, len(pm))
+	for i, period := range pm {
+		ranges[i] = period.pgRange()
+	}
+	return formatPgMultirange(ranges), nil
+}
+
+// DateTimeSpanMultirange is a list of spans stored in PostgreSQL tsmultirange column
+type DateTimeSpanMultirange []DateTimeSpan
+
+// Scan implements the Scanner interface.
+func (sm *DateTimeSpanMultirange) Scan(value interface{}) error {
+	text, err := scanText("DateTimeSpanMultirange", value)
+	if err != nil {
+		return err
+	}
+	ranges, err := parsePgMultirange(text)
+	if err != nil {
+		return err
+	}
+	spans := make(DateTimeSpanMultirange, 0, len(ranges))
+	for _, r := range ranges {
+		span, err := dateTimeSpanFromPgRange(r)
+		if err != nil {
+			return err
+		}
+		spans = append(spans, span)
+	}
+	*sm = spans
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+func (sm DateTimeSpanMultirange) Value() (driver.Value, error) {
+	ranges := make([]pgRange, len(sm))
+	for i, span := range sm {
+		ranges[i] = span.pgRange()
+	}
+	return formatPgMultirange(ranges), nil
+}
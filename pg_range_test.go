@@ -0,0 +1,115 @@
+package time
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodScanDateRange(t *testing.T) {
+	var tests = []struct {
+		daterange string
+		want      Period
+	}{
+		{"[2018-01-01,2018-02-01)", MustParsePeriod("2018-01-01/2018-01-31")},
+		{"[2018-01-01,2018-01-31]", MustParsePeriod("2018-01-01/2018-01-31")},
+		{"(2017-12-31,2018-02-01)", MustParsePeriod("2018-01-01/2018-01-31")},
+		{"[2018-01-01,2018-01-02)", MustParsePeriod("2018-01-01/2018-01-01")},
+		{"[2018-01-01,)", MustParsePeriod("2018-01-01/..")},
+		{"[2018-01-01,infinity)", MustParsePeriod("2018-01-01/..")},
+		{`["2018-01-01","2018-02-01")`, MustParsePeriod("2018-01-01/2018-01-31")},
+	}
+	for _, test := range tests {
+		var period Period
+		assert.NoError(t, period.Scan([]byte(test.daterange)), test.daterange)
+		assert.Equal(t, test.want, period, test.daterange)
+	}
+
+	var period Period
+	assert.Error(t, period.Scan("empty"))
+	assert.Error(t, period.Scan("(,2018-02-01)"))
+	assert.Error(t, period.Scan("[2018-01-01,2018-01-01)"))
+	assert.Error(t, period.Scan("[2018-01-01;2018-01-01)"))
+	assert.Error(t, period.Scan("[2018-01-01,2018-01-01"))
+}
+
+func TestPeriodValueDateRange(t *testing.T) {
+	value, err := MustParsePeriod("2018-01-01/2018-01-31").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "[2018-01-01,2018-02-01)", value)
+
+	value, err = MustParsePeriod("2018-12-31/2018-12-31").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "[2018-12-31,2019-01-01)", value)
+
+	value, err = MustParsePeriod("2018-01-01/..").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "[2018-01-01,)", value)
+}
+
+func TestDateTimeSpanScanTsRange(t *testing.T) {
+	var tests = []struct {
+		tsrange string
+		want    DateTimeSpan
+	}{
+		{`["2018-01-01 10:00:00","2018-01-01 11:30:00")`, MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 11:30")},
+		{`["2018-01-01 10:00:00",)`, MustParseDateTimeSpan("2018-01-01 10:00/..")},
+		{`["2018-01-01 10:00:00",infinity)`, MustParseDateTimeSpan("2018-01-01 10:00/..")},
+		{`["2018-01-01 10:00:00","2018-01-01 11:29:59.999999"]`, MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 11:30")},
+		{`(,"2018-01-01 10:00:00")`, MustParseDateTimeSpan("../2018-01-01 10:00")},
+	}
+	for _, test := range tests {
+		var span DateTimeSpan
+		assert.NoError(t, span.Scan(test.tsrange), test.tsrange)
+		assert.Equal(t, test.want, span, test.tsrange)
+	}
+
+	var span DateTimeSpan
+	assert.Error(t, span.Scan("empty"))
+	assert.Error(t, span.Scan(`(,)`))
+	assert.Error(t, span.Scan(`["2018-01-01 12:00:00","2018-01-01 10:00:00")`))
+}
+
+func TestDateTimeSpanValueTsRange(t *testing.T) {
+	span := NewDateTimeSpan(MustParseLocalDateTime("2018-01-01 10:00"), MustParseLocalDateTime("2018-01-01 11:30").Add(500*Millisecond))
+	value, err := span.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `["2018-01-01 10:00:00","2018-01-01 11:30:00.5")`, value)
+
+	var actual DateTimeSpan
+	assert.NoError(t, actual.Scan(value))
+	assert.Equal(t, span, actual)
+}
+
+func TestPeriodMultirange(t *testing.T) {
+	periods := PeriodMultirange{
+		MustParsePeriod("2018-01-01/2018-01-31"),
+		MustParsePeriod("2018-03-01/.."),
+	}
+	value, err := periods.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{[2018-01-01,2018-02-01),[2018-03-01,)}", value)
+
+	var actual PeriodMultirange
+	assert.NoError(t, actual.Scan([]byte("{[2018-01-01,2018-02-01), [2018-03-01,)}")))
+	assert.Equal(t, periods, actual)
+
+	assert.NoError(t, actual.Scan("{}"))
+	assert.Empty(t, actual)
+	assert.Error(t, actual.Scan("[2018-01-01,2018-02-01)"))
+	assert.Error(t, actual.Scan(nil))
+}
+
+func TestDateTimeSpanMultirange(t *testing.T) {
+	spans := DateTimeSpanMultirange{
+		MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 11:30"),
+		MustParseDateTimeSpan("2018-01-02 10:00/.."),
+	}
+	value, err := spans.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{["2018-01-01 10:00:00","2018-01-01 11:30:00"),["2018-01-02 10:00:00",)}`, value)
+
+	var actual DateTimeSpanMultirange
+	assert.NoError(t, actual.Scan(value))
+	assert.Equal(t, spans, actual)
+}
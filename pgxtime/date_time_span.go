@@ -0,0 +1,95 @@
+package pgxtime
+
+import (
+	"errors"
+
+	"github.com/RnDity/time"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// timestampResolution is the smallest difference between two PostgreSQL timestamps.
+// It is used to convert inclusive upper and exclusive lower bounds of tsrange
+// into half-open DateTimeSpan.
+const timestampResolution = time.Microsecond
+
+// DateTimeSpanCodec is a codec for PostgreSQL tsrange type supporting time.DateTimeSpan.
+// Unbounded or infinite bound is mapped to an open-started or open-ended span.
+type DateTimeSpanCodec struct {
+	pgtype.RangeCodec
+}
+
+func (c *DateTimeSpanCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if _, ok := value.(time.DateTimeSpan); ok {
+		return planWrappedEncode(c.RangeCodec.PlanEncode(m, oid, format, pgtype.Range[pgtype.Timestamp]{}),
+			func(value any) any { return fromDateTimeSpan(value.(time.DateTimeSpan)) })
+	}
+	return c.RangeCodec.PlanEncode(m, oid, format, value)
+}
+
+func (c *DateTimeSpanCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if _, ok := target.(*time.DateTimeSpan); ok {
+		return planConvertedScan(c.RangeCodec.PlanScan(m, oid, format, &pgtype.Range[pgtype.Timestamp]{}),
+			func(r pgtype.Range[pgtype.Timestamp], target any) error {
+				span, err := toDateTimeSpan(r)
+				if err != nil {
+					return err
+				}
+				*target.(*time.DateTimeSpan) = span
+				return nil
+			})
+	}
+	return c.RangeCodec.PlanScan(m, oid, format, target)
+}
+
+func fromDateTimeSpan(span time.DateTimeSpan) pgtype.Range[pgtype.Timestamp] {
+	r := pgtype.Range[pgtype.Timestamp]{
+		LowerType: pgtype.Unbounded,
+		UpperType: pgtype.Unbounded,
+		Valid:     true,
+	}
+	if !span.From().IsNull() {
+		r.Lower = fromLocalDateTime(span.From())
+		r.LowerType = pgtype.Inclusive
+	}
+	if !span.To().IsNull() {
+		r.Upper = fromLocalDateTime(span.To())
+		r.UpperType = pgtype.Exclusive
+	}
+	return r
+}
+
+func toDateTimeSpan(r pgtype.Range[pgtype.Timestamp]) (time.DateTimeSpan, error) {
+	if !r.Valid {
+		return time.DateTimeSpan{}, errors.New("cannot scan NULL into DateTimeSpan")
+	}
+	if r.LowerType == pgtype.Empty {
+		return time.DateTimeSpan{}, errors.New("cannot scan empty tsrange into DateTimeSpan")
+	}
+	if r.Lower.InfinityModifier == pgtype.Infinity || r.Upper.InfinityModifier == pgtype.NegativeInfinity {
+		return time.DateTimeSpan{}, time.ErrPeriodInvalidFromAfterTo
+	}
+	lowerUnbounded := r.LowerType == pgtype.Unbounded || r.Lower.InfinityModifier == pgtype.NegativeInfinity
+	upperUnbounded := r.UpperType == pgtype.Unbounded || r.Upper.InfinityModifier == pgtype.Infinity
+	var from, to time.LocalDateTime
+	if !lowerUnbounded {
+		from = time.NewLocalDateTime(r.Lower.Time)
+		if r.LowerType == pgtype.Exclusive {
+			from = from.Add(timestampResolution)
+		}
+	}
+	if !upperUnbounded {
+		to = time.NewLocalDateTime(r.Upper.Time)
+		if r.UpperType == pgtype.Inclusive {
+			to = to.Add(timestampResolution)
+		}
+	}
+	switch {
+	case lowerUnbounded:
+		return time.NewOpenDateTimeSpanUntil(to)
+	case upperUnbounded:
+		return time.NewOpenDateTimeSpanFrom(from)
+	case from.After(to):
+		return time.DateTimeSpan{}, time.ErrPeriodInvalidFromAfterTo
+	}
+	return time.NewDateTimeSpan(from, to), nil
+}
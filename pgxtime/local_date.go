@@ -0,0 +1,87 @@
+package pgxtime
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RnDity/time"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// LocalDateCodec is a codec for PostgreSQL date type
+// supporting time.LocalDate and time.NullableLocalDate.
+// Infinite dates can't be scanned into them.
+type LocalDateCodec struct {
+	pgtype.DateCodec
+}
+
+func (c *LocalDateCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case time.LocalDate:
+		return planWrappedEncode(c.DateCodec.PlanEncode(m, oid, format, localDateWrapper{}),
+			func(value any) any { return localDateWrapper(value.(time.LocalDate)) })
+	case time.NullableLocalDate:
+		return planWrappedEncode(c.DateCodec.PlanEncode(m, oid, format, nullableLocalDateWrapper{}),
+			func(value any) any { return nullableLocalDateWrapper(value.(time.NullableLocalDate)) })
+	}
+	return c.DateCodec.PlanEncode(m, oid, format, value)
+}
+
+func (c *LocalDateCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *time.LocalDate:
+		return planWrappedScan(c.DateCodec.PlanScan(m, oid, format, (*localDateWrapper)(nil)),
+			func(target any) any { return (*localDateWrapper)(target.(*time.LocalDate)) })
+	case *time.NullableLocalDate:
+		return planWrappedScan(c.DateCodec.PlanScan(m, oid, format, (*nullableLocalDateWrapper)(nil)),
+			func(target any) any { return (*nullableLocalDateWrapper)(target.(*time.NullableLocalDate)) })
+	}
+	return c.DateCodec.PlanScan(m, oid, format, target)
+}
+
+type localDateWrapper time.LocalDate
+
+func (w localDateWrapper) DateValue() (pgtype.Date, error) {
+	return pgtype.Date{Time: time.LocalDate(w).GetStartOfDayUTC(), Valid: true}, nil
+}
+
+func (w *localDateWrapper) ScanDate(v pgtype.Date) error {
+	date, err := toLocalDate(v)
+	if err != nil {
+		return err
+	}
+	*w = localDateWrapper(date)
+	return nil
+}
+
+type nullableLocalDateWrapper time.NullableLocalDate
+
+func (w nullableLocalDateWrapper) DateValue() (pgtype.Date, error) {
+	if !w.Valid {
+		return pgtype.Date{}, nil
+	}
+	return pgtype.Date{Time: w.Date.GetStartOfDayUTC(), Valid: true}, nil
+}
+
+func (w *nullableLocalDateWrapper) ScanDate(v pgtype.Date) error {
+	if !v.Valid {
+		*w = nullableLocalDateWrapper{}
+		return nil
+	}
+	date, err := toLocalDate(v)
+	if err != nil {
+		return err
+	}
+	*w = nullableLocalDateWrapper(time.NewNullableLocalDate(date))
+	return nil
+}
+
+func toLocalDate(v pgtype.Date) (time.LocalDate, error) {
+	if !v.Valid {
+		return time.NullLocalDate, errors.New("cannot scan NULL into LocalDate")
+	}
+	if v.InfinityModifier != pgtype.Finite {
+		return time.NullLocalDate, fmt.Errorf("cannot scan %v into LocalDate", v.InfinityModifier)
+	}
+	return time.ToLocalDate(v.Time), nil
+}
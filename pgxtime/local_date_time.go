@@ -0,0 +1,92 @@
+package pgxtime
+
+import (
+	"errors"
+	"fmt"
+	gotime "time"
+
+	"github.com/RnDity/time"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// LocalDateTimeCodec is a codec for PostgreSQL timestamp type
+// supporting time.LocalDateTime and time.NullableLocalDateTime.
+// Infinite timestamps can't be scanned into them.
+type LocalDateTimeCodec struct {
+	pgtype.TimestampCodec
+}
+
+func (c *LocalDateTimeCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case time.LocalDateTime:
+		return planWrappedEncode(c.TimestampCodec.PlanEncode(m, oid, format, localDateTimeWrapper{}),
+			func(value any) any { return localDateTimeWrapper(value.(time.LocalDateTime)) })
+	case time.NullableLocalDateTime:
+		return planWrappedEncode(c.TimestampCodec.PlanEncode(m, oid, format, nullableLocalDateTimeWrapper{}),
+			func(value any) any { return nullableLocalDateTimeWrapper(value.(time.NullableLocalDateTime)) })
+	}
+	return c.TimestampCodec.PlanEncode(m, oid, format, value)
+}
+
+func (c *LocalDateTimeCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *time.LocalDateTime:
+		return planWrappedScan(c.TimestampCodec.PlanScan(m, oid, format, (*localDateTimeWrapper)(nil)),
+			func(target any) any { return (*localDateTimeWrapper)(target.(*time.LocalDateTime)) })
+	case *time.NullableLocalDateTime:
+		return planWrappedScan(c.TimestampCodec.PlanScan(m, oid, format, (*nullableLocalDateTimeWrapper)(nil)),
+			func(target any) any { return (*nullableLocalDateTimeWrapper)(target.(*time.NullableLocalDateTime)) })
+	}
+	return c.TimestampCodec.PlanScan(m, oid, format, target)
+}
+
+type localDateTimeWrapper time.LocalDateTime
+
+func (w localDateTimeWrapper) TimestampValue() (pgtype.Timestamp, error) {
+	return fromLocalDateTime(time.LocalDateTime(w)), nil
+}
+
+func (w *localDateTimeWrapper) ScanTimestamp(v pgtype.Timestamp) error {
+	dateTime, err := toLocalDateTime(v)
+	if err != nil {
+		return err
+	}
+	*w = localDateTimeWrapper(dateTime)
+	return nil
+}
+
+type nullableLocalDateTimeWrapper time.NullableLocalDateTime
+
+func (w nullableLocalDateTimeWrapper) TimestampValue() (pgtype.Timestamp, error) {
+	if !w.Valid {
+		return pgtype.Timestamp{}, nil
+	}
+	return fromLocalDateTime(w.DateTime), nil
+}
+
+func (w *nullableLocalDateTimeWrapper) ScanTimestamp(v pgtype.Timestamp) error {
+	if !v.Valid {
+		*w = nullableLocalDateTimeWrapper{}
+		return nil
+	}
+	dateTime, err := toLocalDateTime(v)
+	if err != nil {
+		return err
+	}
+	*w = nullableLocalDateTimeWrapper(time.NewNullableLocalDateTime(dateTime))
+	return nil
+}
+
+func fromLocalDateTime(dateTime time.LocalDateTime) pgtype.Timestamp {
+	return pgtype.Timestamp{Time: dateTime.GoTime(gotime.UTC), Valid: true}
+}
+
+func toLocalDateTime(v pgtype.Timestamp) (time.LocalDateTime, error) {
+	if !v.Valid {
+		return time.NullLocalDateTime, errors.New("cannot scan NULL into LocalDateTime")
+	}
+	if v.InfinityModifier != pgtype.Finite {
+		return time.NullLocalDateTime, fmt.Errorf("cannot scan %v into LocalDateTime", v.InfinityModifier)
+	}
+	return time.NewLocalDateTime(v.Time), nil
+}
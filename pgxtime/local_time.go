@@ -0,0 +1,97 @@
+package pgxtime
+
+import (
+	"errors"
+
+	"github.com/RnDity/time"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const microsecondsPerMinute = int64(time.Minute / time.Microsecond)
+
+// LocalTimeCodec is a codec for PostgreSQL time type
+// supporting time.LocalTime and time.NullableLocalTime.
+// Seconds are truncated on scan and 24:00 is scanned as time.Midnight.
+type LocalTimeCodec struct {
+	pgtype.TimeCodec
+}
+
+func (c *LocalTimeCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case time.LocalTime:
+		return planWrappedEncode(c.TimeCodec.PlanEncode(m, oid, format, localTimeWrapper{}),
+			func(value any) any { return localTimeWrapper(value.(time.LocalTime)) })
+	case time.NullableLocalTime:
+		return planWrappedEncode(c.TimeCodec.PlanEncode(m, oid, format, nullableLocalTimeWrapper{}),
+			func(value any) any { return nullableLocalTimeWrapper(value.(time.NullableLocalTime)) })
+	}
+	return c.TimeCodec.PlanEncode(m, oid, format, value)
+}
+
+func (c *LocalTimeCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *time.LocalTime:
+		return planWrappedScan(c.TimeCodec.PlanScan(m, oid, format, (*localTimeWrapper)(nil)),
+			func(target any) any { return (*localTimeWrapper)(target.(*time.LocalTime)) })
+	case *time.NullableLocalTime:
+		return planWrappedScan(c.TimeCodec.PlanScan(m, oid, format, (*nullableLocalTimeWrapper)(nil)),
+			func(target any) any { return (*nullableLocalTimeWrapper)(target.(*time.NullableLocalTime)) })
+	}
+	return c.TimeCodec.PlanScan(m, oid, format, target)
+}
+
+type localTimeWrapper time.LocalTime
+
+func (w localTimeWrapper) TimeValue() (pgtype.Time, error) {
+	return fromLocalTime(time.LocalTime(w))
+}
+
+func (w *localTimeWrapper) ScanTime(v pgtype.Time) error {
+	localTime, err := toLocalTime(v)
+	if err != nil {
+		return err
+	}
+	*w = localTimeWrapper(localTime)
+	return nil
+}
+
+type nullableLocalTimeWrapper time.NullableLocalTime
+
+func (w nullableLocalTimeWrapper) TimeValue() (pgtype.Time, error) {
+	if !w.Valid {
+		return pgtype.Time{}, nil
+	}
+	return fromLocalTime(w.Time)
+}
+
+func (w *nullableLocalTimeWrapper) ScanTime(v pgtype.Time) error {
+	if !v.Valid {
+		*w = nullableLocalTimeWrapper{}
+		return nil
+	}
+	localTime, err := toLocalTime(v)
+	if err != nil {
+		return err
+	}
+	*w = nullableLocalTimeWrapper(time.NewNullableLocalTime(localTime))
+	return nil
+}
+
+func fromLocalTime(t time.LocalTime) (pgtype.Time, error) {
+	if t == time.NullLocalTime {
+		return pgtype.Time{}, errors.New("cannot encode NullLocalTime")
+	}
+	minutes := int64(t.Hour()*60 + t.Minutes())
+	return pgtype.Time{Microseconds: minutes * microsecondsPerMinute, Valid: true}, nil
+}
+
+func toLocalTime(v pgtype.Time) (time.LocalTime, error) {
+	if !v.Valid {
+		return time.NullLocalTime, errors.New("cannot scan NULL into LocalTime")
+	}
+	minutes := v.Microseconds / microsecondsPerMinute
+	if minutes == 24*60 {
+		return time.Midnight, nil
+	}
+	return time.NewLocalTime(int(minutes/60), int(minutes%60))
+}
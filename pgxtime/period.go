@@ -0,0 +1,101 @@
+package pgxtime
+
+import (
+	"errors"
+
+	"github.com/RnDity/time"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PeriodCodec is a codec for PostgreSQL daterange type supporting time.Period.
+// Unbounded or infinite upper bound is mapped to an open period.
+type PeriodCodec struct {
+	pgtype.RangeCodec
+}
+
+func (c *PeriodCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if _, ok := value.(time.Period); ok {
+		return planWrappedEncode(c.RangeCodec.PlanEncode(m, oid, format, pgtype.Range[pgtype.Date]{}),
+			func(value any) any { return fromPeriod(value.(time.Period)) })
+	}
+	return c.RangeCodec.PlanEncode(m, oid, format, value)
+}
+
+func (c *PeriodCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if _, ok := target.(*time.Period); ok {
+		return planConvertedScan(c.RangeCodec.PlanScan(m, oid, format, &pgtype.Range[pgtype.Date]{}),
+			func(r pgtype.Range[pgtype.Date], target any) error {
+				period, err := toPeriod(r)
+				if err != nil {
+					return err
+				}
+				*target.(*time.Period) = period
+				return nil
+			})
+	}
+	return c.RangeCodec.PlanScan(m, oid, format, target)
+}
+
+// fromPeriod converts period into daterange, which is canonically
+// inclusive on the lower and exclusive on the upper bound
+func fromPeriod(p time.Period) pgtype.Range[pgtype.Date] {
+	r := pgtype.Range[pgtype.Date]{
+		Lower:     pgtype.Date{Time: p.From().GetStartOfDayUTC(), Valid: true},
+		LowerType: pgtype.Inclusive,
+		UpperType: pgtype.Unbounded,
+		Valid:     true,
+	}
+	if !p.IsOpen() {
+		r.Upper = pgtype.Date{Time: p.To().Next().GetStartOfDayUTC(), Valid: true}
+		r.UpperType = pgtype.Exclusive
+	}
+	return r
+}
+
+func toPeriod(r pgtype.Range[pgtype.Date]) (time.Period, error) {
+	if !r.Valid {
+		return time.Period{}, errors.New("cannot scan NULL into Period")
+	}
+	if r.LowerType == pgtype.Empty {
+		return time.Period{}, errors.New("cannot scan empty daterange into Period")
+	}
+	if r.LowerType == pgtype.Unbounded || r.Lower.InfinityModifier != pgtype.Finite {
+		return time.Period{}, time.ErrPeriodInvalidParamNull
+	}
+	from := time.ToLocalDate(r.Lower.Time)
+	if r.LowerType == pgtype.Exclusive {
+		from = from.Next()
+	}
+	if r.UpperType == pgtype.Unbounded || r.Upper.InfinityModifier == pgtype.Infinity {
+		return time.NewOpenPeriodFrom(from)
+	}
+	if r.Upper.InfinityModifier != pgtype.Finite {
+		return time.Period{}, time.ErrPeriodInvalidFromAfterTo
+	}
+	to := time.ToLocalDate(r.Upper.Time)
+	if r.UpperType == pgtype.Exclusive {
+		to = to.AddDate(0, 0, -1)
+	}
+	return time.NewPeriod(from, to)
+}
+
+// convertScanPlan scans into an intermediate value and converts it into target
+type convertScanPlan[V any] struct {
+	next    pgtype.ScanPlan
+	convert func(v V, target any) error
+}
+
+func (p *convertScanPlan[V]) Scan(src []byte, target any) error {
+	var v V
+	if err := p.next.Scan(src, &v); err != nil {
+		return err
+	}
+	return p.convert(v, target)
+}
+
+func planConvertedScan[V any](next pgtype.ScanPlan, convert func(v V, target any) error) pgtype.ScanPlan {
+	if next == nil {
+		return nil
+	}
+	return &convertScanPlan[V]{next: next, convert: convert}
+}
@@ -0,0 +1,72 @@
+// Package pgxtime provides pgx codecs for local date and time types,
+// so they are encoded and decoded in binary format without going through
+// the database/sql Scanner and Valuer implementations.
+package pgxtime
+
+import (
+	"github.com/RnDity/time"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Register registers codecs for LocalDate, LocalTime, LocalDateTime, Period and DateTimeSpan
+// (and their nullable counterparts) against PostgreSQL date, time, timestamp, daterange and tsrange types.
+// Use it e.g. in pgxpool.Config.AfterConnect:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxtime.Register(conn.TypeMap())
+//		return nil
+//	}
+func Register(m *pgtype.Map) {
+	date := &pgtype.Type{Name: "date", OID: pgtype.DateOID, Codec: &LocalDateCodec{}}
+	timestamp := &pgtype.Type{Name: "timestamp", OID: pgtype.TimestampOID, Codec: &LocalDateTimeCodec{}}
+	m.RegisterType(date)
+	m.RegisterType(&pgtype.Type{Name: "time", OID: pgtype.TimeOID, Codec: &LocalTimeCodec{}})
+	m.RegisterType(timestamp)
+	m.RegisterType(&pgtype.Type{Name: "daterange", OID: pgtype.DaterangeOID,
+		Codec: &PeriodCodec{RangeCodec: pgtype.RangeCodec{ElementType: date}}})
+	m.RegisterType(&pgtype.Type{Name: "tsrange", OID: pgtype.TsrangeOID,
+		Codec: &DateTimeSpanCodec{RangeCodec: pgtype.RangeCodec{ElementType: timestamp}}})
+
+	m.RegisterDefaultPgType(time.LocalDate{}, "date")
+	m.RegisterDefaultPgType(time.NullableLocalDate{}, "date")
+	m.RegisterDefaultPgType(time.LocalTime{}, "time")
+	m.RegisterDefaultPgType(time.NullableLocalTime{}, "time")
+	m.RegisterDefaultPgType(time.LocalDateTime{}, "timestamp")
+	m.RegisterDefaultPgType(time.NullableLocalDateTime{}, "timestamp")
+	m.RegisterDefaultPgType(time.Period{}, "daterange")
+	m.RegisterDefaultPgType(time.DateTimeSpan{}, "tsrange")
+}
+
+// wrapEncodePlan converts value into a type supported by the next plan
+type wrapEncodePlan struct {
+	wrap func(value any) any
+	next pgtype.EncodePlan
+}
+
+func (p *wrapEncodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	return p.next.Encode(p.wrap(value), buf)
+}
+
+func planWrappedEncode(next pgtype.EncodePlan, wrap func(value any) any) pgtype.EncodePlan {
+	if next == nil {
+		return nil
+	}
+	return &wrapEncodePlan{wrap: wrap, next: next}
+}
+
+// wrapScanPlan converts target into a type supported by the next plan
+type wrapScanPlan struct {
+	wrap func(target any) any
+	next pgtype.ScanPlan
+}
+
+func (p *wrapScanPlan) Scan(src []byte, target any) error {
+	return p.next.Scan(src, p.wrap(target))
+}
+
+func planWrappedScan(next pgtype.ScanPlan, wrap func(target any) any) pgtype.ScanPlan {
+	if next == nil {
+		return nil
+	}
+	return &wrapScanPlan{wrap: wrap, next: next}
+}
@@ -0,0 +1,230 @@
+package pgxtime
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/RnDity/time"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+var formats = []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode}
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+func encode(t *testing.T, m *pgtype.Map, oid uint32, format int16, value any) []byte {
+	plan := m.PlanEncode(oid, format, value)
+	if !assert.NotNil(t, plan, "no encode plan for %T", value) {
+		t.FailNow()
+	}
+	buf, err := plan.Encode(value, nil)
+	assert.NoError(t, err)
+	return buf
+}
+
+func scan(m *pgtype.Map, oid uint32, format int16, src []byte, target any) error {
+	return m.PlanScan(oid, format, target).Scan(src, target)
+}
+
+// testRoundTrip encodes value with registered codecs, checks that stock pgx codecs
+// decode it into expected value and decodes it back into target
+func testRoundTrip(t *testing.T, oid uint32, value any, expected any, target any) {
+	m := newMap()
+	for _, format := range formats {
+		assert.IsType(t, &wrapEncodePlan{}, m.PlanEncode(oid, format, value), "format %v", format)
+		switch m.PlanScan(oid, format, target).(type) {
+		case *wrapScanPlan, *convertScanPlan[pgtype.Range[pgtype.Date]], *convertScanPlan[pgtype.Range[pgtype.Timestamp]]:
+		default:
+			t.Errorf("%T is not scanned by registered codec", target)
+		}
+		buf := encode(t, m, oid, format, value)
+
+		stock := pgtype.NewMap()
+		dataType, _ := stock.TypeForOID(oid)
+		decoded, err := dataType.Codec.DecodeValue(stock, oid, format, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, decoded, "format %v", format)
+
+		assert.NoError(t, scan(m, oid, format, buf, target))
+		assert.Equal(t, value, deref(target), "format %v", format)
+	}
+}
+
+func deref(target any) any {
+	switch target := target.(type) {
+	case *time.LocalDate:
+		return *target
+	case *time.NullableLocalDate:
+		return *target
+	case *time.LocalTime:
+		return *target
+	case *time.NullableLocalTime:
+		return *target
+	case *time.LocalDateTime:
+		return *target
+	case *time.NullableLocalDateTime:
+		return *target
+	case *time.Period:
+		return *target
+	case *time.DateTimeSpan:
+		return *target
+	}
+	panic("unsupported target")
+}
+
+func TestLocalDateCodec(t *testing.T) {
+	date := time.NewLocalDate(2018, 3, 25)
+	testRoundTrip(t, pgtype.DateOID, date, gotime.Date(2018, 3, 25, 0, 0, 0, 0, gotime.UTC), new(time.LocalDate))
+	testRoundTrip(t, pgtype.DateOID, time.NewNullableLocalDate(date),
+		gotime.Date(2018, 3, 25, 0, 0, 0, 0, gotime.UTC), new(time.NullableLocalDate))
+	testRoundTrip(t, pgtype.DateOID, time.NullableLocalDate{}, nil, new(time.NullableLocalDate))
+}
+
+func TestLocalDateCodecInfinity(t *testing.T) {
+	m := newMap()
+	for _, format := range formats {
+		buf := encode(t, m, pgtype.DateOID, format, pgtype.Date{InfinityModifier: pgtype.Infinity, Valid: true})
+		var date time.LocalDate
+		assert.Error(t, scan(m, pgtype.DateOID, format, buf, &date))
+		assert.Error(t, scan(m, pgtype.DateOID, format, nil, &date))
+	}
+}
+
+func TestLocalTimeCodec(t *testing.T) {
+	localTime := time.MustCreateNewLocalTime(23, 45)
+	expected := pgtype.Time{Microseconds: int64(23*time.Hour+45*time.Minute) / 1000, Valid: true}
+	testRoundTrip(t, pgtype.TimeOID, localTime, expected, new(time.LocalTime))
+	testRoundTrip(t, pgtype.TimeOID, time.NewNullableLocalTime(localTime), expected, new(time.NullableLocalTime))
+	testRoundTrip(t, pgtype.TimeOID, time.NullableLocalTime{}, nil, new(time.NullableLocalTime))
+
+	m := newMap()
+	buf := encode(t, m, pgtype.TimeOID, pgtype.BinaryFormatCode, pgtype.Time{Microseconds: 24 * 3600 * 1000000, Valid: true})
+	var actual time.LocalTime
+	assert.NoError(t, scan(m, pgtype.TimeOID, pgtype.BinaryFormatCode, buf, &actual))
+	assert.Equal(t, time.Midnight, actual)
+
+	_, err := m.PlanEncode(pgtype.TimeOID, pgtype.BinaryFormatCode, time.NullLocalTime).Encode(time.NullLocalTime, nil)
+	assert.Error(t, err)
+}
+
+func TestLocalDateTimeCodec(t *testing.T) {
+	goTime := gotime.Date(2018, 3, 25, 2, 30, 15, 123456000, gotime.UTC)
+	dateTime := time.NewLocalDateTime(goTime)
+	testRoundTrip(t, pgtype.TimestampOID, dateTime, goTime, new(time.LocalDateTime))
+	testRoundTrip(t, pgtype.TimestampOID, time.NewNullableLocalDateTime(dateTime), goTime, new(time.NullableLocalDateTime))
+	testRoundTrip(t, pgtype.TimestampOID, time.NullableLocalDateTime{}, nil, new(time.NullableLocalDateTime))
+
+	m := newMap()
+	buf := encode(t, m, pgtype.TimestampOID, pgtype.BinaryFormatCode,
+		pgtype.Timestamp{InfinityModifier: pgtype.NegativeInfinity, Valid: true})
+	var actual time.LocalDateTime
+	assert.Error(t, scan(m, pgtype.TimestampOID, pgtype.BinaryFormatCode, buf, &actual))
+}
+
+func TestPeriodCodec(t *testing.T) {
+	date := func(year int, month gotime.Month, day int) pgtype.Date {
+		return pgtype.Date{Time: gotime.Date(year, month, day, 0, 0, 0, 0, gotime.UTC), Valid: true}
+	}
+	testRoundTrip(t, pgtype.DaterangeOID, time.MustParsePeriod("2018-01-01/2018-01-31"),
+		pgtype.Range[any]{Lower: date(2018, 1, 1).Time, Upper: date(2018, 2, 1).Time,
+			LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Valid: true},
+		new(time.Period))
+	testRoundTrip(t, pgtype.DaterangeOID, time.MustParsePeriod("2018-01-01/.."),
+		pgtype.Range[any]{Lower: date(2018, 1, 1).Time,
+			LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Valid: true},
+		new(time.Period))
+}
+
+func TestPeriodCodecBounds(t *testing.T) {
+	date := func(year int, month gotime.Month, day int) pgtype.Date {
+		return pgtype.Date{Time: gotime.Date(year, month, day, 0, 0, 0, 0, gotime.UTC), Valid: true}
+	}
+	var tests = []struct {
+		daterange pgtype.Range[pgtype.Date]
+		want      time.Period
+	}{
+		{pgtype.Range[pgtype.Date]{Lower: date(2018, 1, 1), Upper: date(2018, 1, 31),
+			LowerType: pgtype.Inclusive, UpperType: pgtype.Inclusive, Valid: true},
+			time.MustParsePeriod("2018-01-01/2018-01-31")},
+		{pgtype.Range[pgtype.Date]{Lower: date(2017, 12, 31), Upper: date(2018, 2, 1),
+			LowerType: pgtype.Exclusive, UpperType: pgtype.Exclusive, Valid: true},
+			time.MustParsePeriod("2018-01-01/2018-01-31")},
+		{pgtype.Range[pgtype.Date]{Lower: date(2018, 1, 1), Upper: pgtype.Date{InfinityModifier: pgtype.Infinity, Valid: true},
+			LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Valid: true},
+			time.MustParsePeriod("2018-01-01/..")},
+	}
+	m := newMap()
+	for _, test := range tests {
+		for _, format := range formats {
+			buf := encode(t, m, pgtype.DaterangeOID, format, test.daterange)
+			var period time.Period
+			assert.NoError(t, scan(m, pgtype.DaterangeOID, format, buf, &period))
+			assert.Equal(t, test.want, period)
+		}
+	}
+
+	var period time.Period
+	buf := encode(t, m, pgtype.DaterangeOID, pgtype.BinaryFormatCode, pgtype.Range[pgtype.Date]{
+		LowerType: pgtype.Empty, UpperType: pgtype.Empty, Valid: true})
+	assert.Error(t, scan(m, pgtype.DaterangeOID, pgtype.BinaryFormatCode, buf, &period))
+	buf = encode(t, m, pgtype.DaterangeOID, pgtype.BinaryFormatCode, pgtype.Range[pgtype.Date]{
+		Upper: date(2018, 1, 1), LowerType: pgtype.Unbounded, UpperType: pgtype.Exclusive, Valid: true})
+	assert.Equal(t, time.ErrPeriodInvalidParamNull, scan(m, pgtype.DaterangeOID, pgtype.BinaryFormatCode, buf, &period))
+	assert.Error(t, scan(m, pgtype.DaterangeOID, pgtype.BinaryFormatCode, nil, &period))
+}
+
+func TestDateTimeSpanCodec(t *testing.T) {
+	from := gotime.Date(2018, 1, 1, 10, 0, 0, 0, gotime.UTC)
+	to := gotime.Date(2018, 1, 1, 11, 30, 0, 0, gotime.UTC)
+	testRoundTrip(t, pgtype.TsrangeOID, time.NewDateTimeSpan(time.NewLocalDateTime(from), time.NewLocalDateTime(to)),
+		pgtype.Range[any]{Lower: from, Upper: to, LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Valid: true},
+		new(time.DateTimeSpan))
+	testRoundTrip(t, pgtype.TsrangeOID, time.MustNewOpenDateTimeSpanFrom(time.NewLocalDateTime(from)),
+		pgtype.Range[any]{Lower: from, LowerType: pgtype.Inclusive, UpperType: pgtype.Unbounded, Valid: true},
+		new(time.DateTimeSpan))
+	testRoundTrip(t, pgtype.TsrangeOID, time.MustNewOpenDateTimeSpanUntil(time.NewLocalDateTime(to)),
+		pgtype.Range[any]{Upper: to, LowerType: pgtype.Unbounded, UpperType: pgtype.Exclusive, Valid: true},
+		new(time.DateTimeSpan))
+
+	m := newMap()
+	buf := encode(t, m, pgtype.TsrangeOID, pgtype.BinaryFormatCode, pgtype.Range[pgtype.Timestamp]{
+		Lower:     pgtype.Timestamp{Time: from, Valid: true},
+		Upper:     pgtype.Timestamp{InfinityModifier: pgtype.Infinity, Valid: true},
+		LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Valid: true})
+	var span time.DateTimeSpan
+	assert.NoError(t, scan(m, pgtype.TsrangeOID, pgtype.BinaryFormatCode, buf, &span))
+	assert.Equal(t, time.MustNewOpenDateTimeSpanFrom(time.NewLocalDateTime(from)), span)
+
+	buf = encode(t, m, pgtype.TsrangeOID, pgtype.BinaryFormatCode, pgtype.Range[pgtype.Timestamp]{
+		Lower:     pgtype.Timestamp{Time: from, Valid: true},
+		Upper:     pgtype.Timestamp{Time: to.Add(-gotime.Microsecond), Valid: true},
+		LowerType: pgtype.Inclusive, UpperType: pgtype.Inclusive, Valid: true})
+	assert.NoError(t, scan(m, pgtype.TsrangeOID, pgtype.BinaryFormatCode, buf, &span))
+	assert.Equal(t, time.NewDateTimeSpan(time.NewLocalDateTime(from), time.NewLocalDateTime(to)), span)
+
+	buf = encode(t, m, pgtype.TsrangeOID, pgtype.BinaryFormatCode, pgtype.Range[pgtype.Timestamp]{
+		Lower:     pgtype.Timestamp{InfinityModifier: pgtype.NegativeInfinity, Valid: true},
+		Upper:     pgtype.Timestamp{Time: to, Valid: true},
+		LowerType: pgtype.Inclusive, UpperType: pgtype.Exclusive, Valid: true})
+	assert.NoError(t, scan(m, pgtype.TsrangeOID, pgtype.BinaryFormatCode, buf, &span))
+	assert.Equal(t, time.MustNewOpenDateTimeSpanUntil(time.NewLocalDateTime(to)), span)
+}
+
+func TestRegisterDefaultPgTypes(t *testing.T) {
+	m := newMap()
+	for value, name := range map[any]string{
+		time.NewLocalDate(2018, 1, 1):                   "date",
+		time.Midnight:                                   "time",
+		time.MustParseLocalDateTime("2018-01-01 10:00"): "timestamp",
+		time.MustParsePeriod("2018-01-01/.."):           "daterange",
+	} {
+		dataType, ok := m.TypeForValue(value)
+		assert.True(t, ok)
+		assert.Equal(t, name, dataType.Name)
+	}
+}
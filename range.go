@@ -0,0 +1,471 @@
+package time
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrRangeInvalidLowerAfterUpper is returned when Range creating is called
+// with lower bound value after upper bound value
+var ErrRangeInvalidLowerAfterUpper = errors.New("Invalid range - lower bound is after upper bound")
+
+// Comparable is implemented by types which values are ordered, e.g. LocalDate, LocalDateTime and LocalTime
+type Comparable[T any] interface {
+	// Compare returns -1, 0 or +1 when value is before, equal or after other value
+	Compare(other T) int
+}
+
+// BoundType tells whether a bound of Range includes its value
+type BoundType int
+
+const (
+	// BoundUnbounded is a bound without a value, the range extends indefinitely on that side
+	BoundUnbounded BoundType = iota
+	// BoundClosed is a bound including its value
+	BoundClosed
+	// BoundOpen is a bound excluding its value
+	BoundOpen
+)
+
+// Bound is a lower or upper end of Range
+type Bound[T Comparable[T]] struct {
+	value     T
+	boundType BoundType
+}
+
+// ClosedBound creates bound including value
+func ClosedBound[T Comparable[T]](value T) Bound[T] {
+	return Bound[T]{value: value, boundType: BoundClosed}
+}
+
+// OpenBound creates bound excluding value
+func OpenBound[T Comparable[T]](value T) Bound[T] {
+	return Bound[T]{value: value, boundType: BoundOpen}
+}
+
+// UnboundedBound creates bound without a value
+func UnboundedBound[T Comparable[T]]() Bound[T] {
+	return Bound[T]{boundType: BoundUnbounded}
+}
+
+// Value returns value of the bound, zero value for an unbounded one
+func (b Bound[T]) Value() T {
+	return b.value
+}
+
+// Type returns whether the bound is closed, open or unbounded
+func (b Bound[T]) Type() BoundType {
+	return b.boundType
+}
+
+// IsUnbounded tells if bound has no value
+func (b Bound[T]) IsUnbounded() bool {
+	return b.boundType == BoundUnbounded
+}
+
+// Range is a continuous range of ordered values between lower and upper bounds,
+// each of them closed, open or unbounded, e.g. [2018-01-01, 2018-02-01) or [10:00, ∞).
+// Period, DateTimeSpan and LocalTimeSpan can be converted to and from Range with their Range methods
+// and PeriodFromRange, DateTimeSpanFromRange and LocalTimeSpanFromRange.
+type Range[T Comparable[T]] struct {
+	lower Bound[T]
+	upper Bound[T]
+}
+
+// NewRange creates Range between bounds. Lower value can't be after upper one.
+// Equal values make an empty range unless both bounds are closed.
+func NewRange[T Comparable[T]](lower, upper Bound[T]) (Range[T], error) {
+	if !lower.IsUnbounded() && !upper.IsUnbounded() && lower.value.Compare(upper.value) > 0 {
+		return Range[T]{}, ErrRangeInvalidLowerAfterUpper
+	}
+	return Range[T]{lower: lower, upper: upper}, nil
+}
+
+// MustNewRange is like NewRange but panics on error
+func MustNewRange[T Comparable[T]](lower, upper Bound[T]) Range[T] {
+	r, err := NewRange(lower, upper)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Lower returns lower bound of the range
+func (r Range[T]) Lower() Bound[T] {
+	return r.lower
+}
+
+// Upper returns upper bound of the range
+func (r Range[T]) Upper() Bound[T] {
+	return r.upper
+}
+
+// IsEmpty tells if range contains no values, e.g. [a, a)
+func (r Range[T]) IsEmpty() bool {
+	return !r.lower.IsUnbounded() && !r.upper.IsUnbounded() && r.lower.value.Compare(r.upper.value) == 0 &&
+		(r.lower.boundType == BoundOpen || r.upper.boundType == BoundOpen)
+}
+
+// Contains tells if range contains value
+func (r Range[T]) Contains(value T) bool {
+	point := Bound[T]{value: value, boundType: BoundClosed}
+	return touch(r.upper, point) > 0 && touch(point, r.lower) > 0
+}
+
+// Encloses tells if range contains all values of other range
+func (r Range[T]) Encloses(other Range[T]) bool {
+	return compareLower(r.lower, other.lower) <= 0 && compareUpper(r.upper, other.upper) >= 0
+}
+
+// Overlaps tells if ranges have any value in common
+func (r Range[T]) Overlaps(other Range[T]) bool {
+	return !r.IsEmpty() && !other.IsEmpty() && touch(r.upper, other.lower) > 0 && touch(other.upper, r.lower) > 0
+}
+
+// Intersection returns range of values common to both ranges, false when ranges don't overlap
+func (r Range[T]) Intersection(other Range[T]) (Range[T], bool) {
+	if !r.Overlaps(other) {
+		return Range[T]{}, false
+	}
+	intersection := r
+	if compareLower(other.lower, r.lower) > 0 {
+		intersection.lower = other.lower
+	}
+	if compareUpper(other.upper, r.upper) < 0 {
+		intersection.upper = other.upper
+	}
+	return intersection, true
+}
+
+// Relation returns which of Allen's interval relations holds between the range and other range.
+// Ranges meet when one ends where the other starts and exactly one of these bounds is closed,
+// e.g. [1, 2) meets [2, 3]. Relations of empty ranges are determined by their bounds.
+func (r Range[T]) Relation(other Range[T]) IntervalRelation {
+	switch touch(r.upper, other.lower) {
+	case -1:
+		return RelationBefore
+	case 0:
+		return RelationMeets
+	}
+	switch touch(other.upper, r.lower) {
+	case -1:
+		return RelationAfter
+	case 0:
+		return RelationMetBy
+	}
+	lower, upper := compareLower(r.lower, other.lower), compareUpper(r.upper, other.upper)
+	switch {
+	case lower == 0 && upper == 0:
+		return RelationEquals
+	case lower == 0:
+		if upper < 0 {
+			return RelationStarts
+		}
+		return RelationStartedBy
+	case upper == 0:
+		if lower > 0 {
+			return RelationFinishes
+		}
+		return RelationFinishedBy
+	case lower > 0 && upper < 0:
+		return RelationDuring
+	case lower < 0 && upper > 0:
+		return RelationContains
+	case lower < 0:
+		return RelationOverlaps
+	}
+	return RelationOverlappedBy
+}
+
+// Before tells if range ends before other range starts, with a gap between them
+func (r Range[T]) Before(other Range[T]) bool {
+	return r.Relation(other) == RelationBefore
+}
+
+// After tells if range starts after other range ends, with a gap between them
+func (r Range[T]) After(other Range[T]) bool {
+	return r.Relation(other) == RelationAfter
+}
+
+// Meets tells if range ends exactly where other range starts
+func (r Range[T]) Meets(other Range[T]) bool {
+	return r.Relation(other) == RelationMeets
+}
+
+// MetBy tells if range starts exactly where other range ends
+func (r Range[T]) MetBy(other Range[T]) bool {
+	return r.Relation(other) == RelationMetBy
+}
+
+// OverlapsStartOf tells if range starts before other range and ends within it
+func (r Range[T]) OverlapsStartOf(other Range[T]) bool {
+	return r.Relation(other) == RelationOverlaps
+}
+
+// OverlapsEndOf tells if range starts within other range and ends after it
+func (r Range[T]) OverlapsEndOf(other Range[T]) bool {
+	return r.Relation(other) == RelationOverlappedBy
+}
+
+// Starts tells if ranges start together and range ends first
+func (r Range[T]) Starts(other Range[T]) bool {
+	return r.Relation(other) == RelationStarts
+}
+
+// StartedBy tells if ranges start together and other range ends first
+func (r Range[T]) StartedBy(other Range[T]) bool {
+	return r.Relation(other) == RelationStartedBy
+}
+
+// During tells if range starts after other range starts and ends before it ends
+func (r Range[T]) During(other Range[T]) bool {
+	return r.Relation(other) == RelationDuring
+}
+
+// ContainsStrictly tells if other range starts after range starts and ends before it ends
+func (r Range[T]) ContainsStrictly(other Range[T]) bool {
+	return r.Relation(other) == RelationContains
+}
+
+// Finishes tells if ranges end together and range starts last
+func (r Range[T]) Finishes(other Range[T]) bool {
+	return r.Relation(other) == RelationFinishes
+}
+
+// FinishedBy tells if ranges end together and other range starts last
+func (r Range[T]) FinishedBy(other Range[T]) bool {
+	return r.Relation(other) == RelationFinishedBy
+}
+
+// Equals tells if ranges have the same bounds
+func (r Range[T]) Equals(other Range[T]) bool {
+	return r.Relation(other) == RelationEquals
+}
+
+// String formats range as in PostgreSQL, e.g. "[2018-01-01,2018-02-01)" or "[10:00,)"
+func (r Range[T]) String() string {
+	var sb strings.Builder
+	if r.lower.boundType == BoundClosed {
+		sb.WriteByte('[')
+	} else {
+		sb.WriteByte('(')
+	}
+	if !r.lower.IsUnbounded() {
+		sb.WriteString(fmt.Sprint(r.lower.value))
+	}
+	sb.WriteByte(',')
+	if !r.upper.IsUnbounded() {
+		sb.WriteString(fmt.Sprint(r.upper.value))
+	}
+	if r.upper.boundType == BoundClosed {
+		sb.WriteByte(']')
+	} else {
+		sb.WriteByte(')')
+	}
+	return sb.String()
+}
+
+// compareLower compares lower bounds, the one admitting smaller values is less
+func compareLower[T Comparable[T]](a, b Bound[T]) int {
+	switch {
+	case a.IsUnbounded() && b.IsUnbounded():
+		return 0
+	case a.IsUnbounded():
+		return -1
+	case b.IsUnbounded():
+		return 1
+	}
+	if c := a.value.Compare(b.value); c != 0 {
+		return c
+	}
+	return compareBoundTypes(a.boundType, b.boundType)
+}
+
+// compareUpper compares upper bounds, the one admitting greater values is greater
+func compareUpper[T Comparable[T]](a, b Bound[T]) int {
+	switch {
+	case a.IsUnbounded() && b.IsUnbounded():
+		return 0
+	case a.IsUnbounded():
+		return 1
+	case b.IsUnbounded():
+		return -1
+	}
+	if c := a.value.Compare(b.value); c != 0 {
+		return c
+	}
+	return -compareBoundTypes(a.boundType, b.boundType)
+}
+
+// compareBoundTypes returns -1 when only a is closed, +1 when only b is closed and 0 otherwise
+func compareBoundTypes(a, b BoundType) int {
+	switch {
+	case a == b:
+		return 0
+	case a == BoundClosed:
+		return -1
+	}
+	return 1
+}
+
+// touch compares upper bound of a range with lower bound of a following range.
+// It returns -1 when there is a gap between them, 0 when they meet without a common value
+// and +1 when they overlap.
+func touch[T Comparable[T]](upper, lower Bound[T]) int {
+	if upper.IsUnbounded() || lower.IsUnbounded() {
+		return 1
+	}
+	if c := upper.value.Compare(lower.value); c != 0 {
+		return c
+	}
+	switch {
+	case upper.boundType == BoundClosed && lower.boundType == BoundClosed:
+		return 1
+	case upper.boundType == BoundOpen && lower.boundType == BoundOpen:
+		return -1
+	}
+	return 0
+}
+
+// IntervalRelation is one of 13 relations of Allen's interval algebra,
+// exactly one of which holds between any two intervals
+type IntervalRelation int
+
+// Relations of Allen's interval algebra, e.g. RelationBefore when the first interval is before the second one
+const (
+	RelationBefore IntervalRelation = iota
+	RelationMeets
+	RelationOverlaps
+	RelationStarts
+	RelationDuring
+	RelationFinishes
+	RelationEquals
+	RelationFinishedBy
+	RelationContains
+	RelationStartedBy
+	RelationOverlappedBy
+	RelationMetBy
+	RelationAfter
+)
+
+var intervalRelationNames = [...]string{
+	"before", "meets", "overlaps", "starts", "during", "finishes", "equals",
+	"finished by", "contains", "started by", "overlapped by", "met by", "after",
+}
+
+// Inverse returns relation of the second interval to the first one, e.g. RelationAfter for RelationBefore
+func (r IntervalRelation) Inverse() IntervalRelation {
+	return RelationAfter - r
+}
+
+func (r IntervalRelation) String() string {
+	if r < RelationBefore || r > RelationAfter {
+		return fmt.Sprintf("IntervalRelation(%d)", int(r))
+	}
+	return intervalRelationNames[r]
+}
+
+// Range returns period as range of dates from its first day (inclusive)
+// to the day after its last day (exclusive), so that adjacent periods meet.
+// Open period has unbounded upper bound.
+func (p Period) Range() Range[LocalDate] {
+	if p.IsOpen() {
+		return Range[LocalDate]{lower: ClosedBound(p.from), upper: UnboundedBound[LocalDate]()}
+	}
+	return Range[LocalDate]{lower: ClosedBound(p.from), upper: OpenBound(p.to.Next())}
+}
+
+// PeriodFromRange creates Period of days in range. Range must have lower bound and must not be empty,
+// unbounded upper bound makes an open period.
+func PeriodFromRange(r Range[LocalDate]) (Period, error) {
+	if r.lower.IsUnbounded() {
+		return Period{from: NullLocalDate, to: NullLocalDate}, ErrPeriodInvalidParamNull
+	}
+	from := r.lower.value
+	if r.lower.boundType == BoundOpen {
+		from = from.Next()
+	}
+	if r.upper.IsUnbounded() {
+		return NewOpenPeriodFrom(from)
+	}
+	to := r.upper.value
+	if r.upper.boundType == BoundOpen {
+		to = to.AddDate(0, 0, -1)
+	}
+	return NewPeriod(from, to)
+}
+
+// Range returns span as range from its start (inclusive) to its end (exclusive).
+// Missing bound of an open span is unbounded.
+func (ts DateTimeSpan) Range() Range[LocalDateTime] {
+	r := Range[LocalDateTime]{lower: ClosedBound(ts.from), upper: OpenBound(ts.to)}
+	if ts.from.IsNull() {
+		r.lower = UnboundedBound[LocalDateTime]()
+	}
+	if ts.to.IsNull() {
+		r.upper = UnboundedBound[LocalDateTime]()
+	}
+	return r
+}
+
+// DateTimeSpanFromRange creates DateTimeSpan of moments in range. Unbounded lower or upper bound
+// makes an open span, but range must have at least one bound. Open lower and closed upper bounds
+// are moved by a nanosecond.
+func DateTimeSpanFromRange(r Range[LocalDateTime]) (DateTimeSpan, error) {
+	var from, to LocalDateTime
+	if !r.lower.IsUnbounded() {
+		from = r.lower.value
+		if r.lower.boundType == BoundOpen {
+			from = from.Add(Nanosecond)
+		}
+	}
+	if !r.upper.IsUnbounded() {
+		to = r.upper.value
+		if r.upper.boundType == BoundClosed {
+			to = to.Add(Nanosecond)
+		}
+	}
+	return newValidOpenDateTimeSpan(from, to)
+}
+
+// Range returns time span as range from its start (inclusive) to its end (exclusive).
+// Span ending at Midnight lasts until the end of the day, so it has unbounded upper bound.
+func (ts LocalTimeSpan) Range() Range[LocalTime] {
+	if ts.to == Midnight {
+		return Range[LocalTime]{lower: ClosedBound(ts.from), upper: UnboundedBound[LocalTime]()}
+	}
+	return Range[LocalTime]{lower: ClosedBound(ts.from), upper: OpenBound(ts.to)}
+}
+
+// LocalTimeSpanFromRange creates LocalTimeSpan of minutes in range. Unbounded lower bound
+// starts at Midnight and unbounded upper one ends at Midnight at the end of the day.
+// Open lower and closed upper bounds are moved by a minute.
+func LocalTimeSpanFromRange(r Range[LocalTime]) (LocalTimeSpan, error) {
+	from := Midnight
+	if !r.lower.IsUnbounded() {
+		from = r.lower.value
+		if r.lower.boundType == BoundOpen {
+			from = minuteAfter(from)
+			if from == Midnight {
+				return LocalTimeSpan{from: NullLocalTime, to: NullLocalTime}, ErrPeriodInvalidFromAfterTo
+			}
+		}
+	}
+	to := Midnight
+	if !r.upper.IsUnbounded() {
+		to = r.upper.value
+		if r.upper.boundType == BoundClosed {
+			to = minuteAfter(to)
+		} else if to == Midnight {
+			return LocalTimeSpan{from: NullLocalTime, to: NullLocalTime}, ErrPeriodInvalidFromAfterTo
+		}
+	}
+	return newValidTimeSpan(from, to)
+}
+
+// minuteAfter returns time a minute later, Midnight after 23:59
+func minuteAfter(t LocalTime) LocalTime {
+	minutes := (t.hour*60 + t.minute + 1) % (24 * 60)
+	return MustCreateNewLocalTime(minutes/60, minutes%60)
+}
@@ -0,0 +1,163 @@
+package time
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func dateRange(lower, upper Bound[LocalDate]) Range[LocalDate] {
+	return MustNewRange(lower, upper)
+}
+
+func date(value string) LocalDate {
+	return MustParseLocalDate(value)
+}
+
+func TestNewRange(t *testing.T) {
+	_, err := NewRange(ClosedBound(date("2018-01-02")), ClosedBound(date("2018-01-01")))
+	assert.Equal(t, ErrRangeInvalidLowerAfterUpper, err)
+	_, err = NewRange(ClosedBound(MustParseLocalTime("10:00")), OpenBound(MustParseLocalTime("09:00")))
+	assert.Equal(t, ErrRangeInvalidLowerAfterUpper, err)
+
+	r, err := NewRange(ClosedBound(date("2018-01-01")), UnboundedBound[LocalDate]())
+	assert.NoError(t, err)
+	assert.Equal(t, BoundClosed, r.Lower().Type())
+	assert.Equal(t, date("2018-01-01"), r.Lower().Value())
+	assert.True(t, r.Upper().IsUnbounded())
+	assert.Equal(t, "[2018-01-01,)", r.String())
+	assert.Equal(t, "(,2018-01-01]", dateRange(UnboundedBound[LocalDate](), ClosedBound(date("2018-01-01"))).String())
+
+	assert.True(t, dateRange(ClosedBound(date("2018-01-01")), OpenBound(date("2018-01-01"))).IsEmpty())
+	assert.False(t, dateRange(ClosedBound(date("2018-01-01")), ClosedBound(date("2018-01-01"))).IsEmpty())
+}
+
+func TestRangeContainsAndEncloses(t *testing.T) {
+	r := dateRange(OpenBound(date("2018-01-01")), ClosedBound(date("2018-01-10")))
+	assert.False(t, r.Contains(date("2018-01-01")))
+	assert.True(t, r.Contains(date("2018-01-02")))
+	assert.True(t, r.Contains(date("2018-01-10")))
+	assert.False(t, r.Contains(date("2018-01-11")))
+
+	unbounded := dateRange(UnboundedBound[LocalDate](), OpenBound(date("2018-01-10")))
+	assert.True(t, unbounded.Contains(date("0001-01-01")))
+	assert.False(t, unbounded.Contains(date("2018-01-10")))
+	assert.True(t, unbounded.Encloses(dateRange(OpenBound(date("2018-01-01")), OpenBound(date("2018-01-10")))))
+	assert.False(t, unbounded.Encloses(r))
+	assert.True(t, r.Encloses(r))
+}
+
+func TestRangeOverlapsAndIntersection(t *testing.T) {
+	r := dateRange(ClosedBound(date("2018-01-01")), OpenBound(date("2018-01-10")))
+
+	assert.False(t, r.Overlaps(dateRange(ClosedBound(date("2018-01-10")), UnboundedBound[LocalDate]())))
+	assert.True(t, r.Overlaps(dateRange(ClosedBound(date("2018-01-09")), UnboundedBound[LocalDate]())))
+	assert.False(t, r.Overlaps(dateRange(ClosedBound(date("2018-01-05")), OpenBound(date("2018-01-05")))))
+
+	intersection, ok := r.Intersection(dateRange(OpenBound(date("2018-01-05")), UnboundedBound[LocalDate]()))
+	assert.True(t, ok)
+	assert.Equal(t, dateRange(OpenBound(date("2018-01-05")), OpenBound(date("2018-01-10"))), intersection)
+	_, ok = r.Intersection(dateRange(ClosedBound(date("2018-01-10")), ClosedBound(date("2018-01-20"))))
+	assert.False(t, ok)
+}
+
+func TestRangeRelation(t *testing.T) {
+	closed := func(from, to int) Range[LocalDate] {
+		return dateRange(ClosedBound(NewLocalDate(2018, 1, from)), ClosedBound(NewLocalDate(2018, 1, to)))
+	}
+	closedOpen := func(from, to int) Range[LocalDate] {
+		return dateRange(ClosedBound(NewLocalDate(2018, 1, from)), OpenBound(NewLocalDate(2018, 1, to)))
+	}
+	for _, tc := range []struct {
+		first, second Range[LocalDate]
+		relation      IntervalRelation
+	}{
+		{closed(1, 2), closed(4, 5), RelationBefore},
+		{closedOpen(1, 4), closed(4, 5), RelationMeets},
+		{closed(1, 4), closedOpen(4, 5), RelationOverlaps},
+		{dateRange(ClosedBound(NewLocalDate(2018, 1, 1)), OpenBound(NewLocalDate(2018, 1, 4))),
+			dateRange(OpenBound(NewLocalDate(2018, 1, 4)), ClosedBound(NewLocalDate(2018, 1, 5))), RelationBefore},
+		{closed(1, 4), closed(3, 5), RelationOverlaps},
+		{closed(1, 4), closed(1, 5), RelationStarts},
+		{closedOpen(1, 5), closed(1, 5), RelationStarts},
+		{closed(2, 4), closed(1, 5), RelationDuring},
+		{closed(2, 5), closed(1, 5), RelationFinishes},
+		{closed(1, 5), closed(1, 5), RelationEquals},
+		{closed(1, 5), dateRange(UnboundedBound[LocalDate](), ClosedBound(NewLocalDate(2018, 1, 5))), RelationFinishes},
+		{dateRange(ClosedBound(NewLocalDate(2018, 1, 3)), UnboundedBound[LocalDate]()), closed(1, 5), RelationOverlappedBy},
+	} {
+		assert.Equal(t, tc.relation, tc.first.Relation(tc.second), "%v %v", tc.first, tc.second)
+		assert.Equal(t, tc.relation.Inverse(), tc.second.Relation(tc.first), "%v %v", tc.second, tc.first)
+	}
+
+	assert.True(t, closed(1, 2).Before(closed(4, 5)))
+	assert.True(t, closed(4, 5).After(closed(1, 2)))
+	assert.True(t, closedOpen(1, 4).Meets(closed(4, 5)))
+	assert.True(t, closed(4, 5).MetBy(closedOpen(1, 4)))
+	assert.True(t, closed(1, 4).OverlapsStartOf(closed(3, 5)))
+	assert.True(t, closed(3, 5).OverlapsEndOf(closed(1, 4)))
+	assert.True(t, closed(1, 4).Starts(closed(1, 5)))
+	assert.True(t, closed(1, 5).StartedBy(closed(1, 4)))
+	assert.True(t, closed(2, 4).During(closed(1, 5)))
+	assert.True(t, closed(1, 5).ContainsStrictly(closed(2, 4)))
+	assert.True(t, closed(2, 5).Finishes(closed(1, 5)))
+	assert.True(t, closed(1, 5).FinishedBy(closed(2, 5)))
+	assert.True(t, closed(1, 5).Equals(closed(1, 5)))
+	assert.False(t, closed(1, 5).Equals(closedOpen(1, 5)))
+
+	assert.Equal(t, "overlapped by", RelationOverlappedBy.String())
+	assert.Equal(t, "IntervalRelation(13)", IntervalRelation(13).String())
+}
+
+func TestPeriodRange(t *testing.T) {
+	period := MustParsePeriod("2018-01-01/2018-01-31")
+	assert.Equal(t, "[2018-01-01,2018-02-01)", period.Range().String())
+	assert.True(t, period.Range().Meets(MustParsePeriod("2018-02-01/..").Range()))
+	assert.Equal(t, "[2018-02-01,)", MustParsePeriod("2018-02-01/..").Range().String())
+
+	for _, p := range []Period{period, MustParsePeriod("2018-02-01/..")} {
+		converted, err := PeriodFromRange(p.Range())
+		assert.NoError(t, err)
+		assert.Equal(t, p, converted)
+	}
+	converted, err := PeriodFromRange(dateRange(OpenBound(date("2017-12-31")), ClosedBound(date("2018-01-31"))))
+	assert.NoError(t, err)
+	assert.Equal(t, period, converted)
+	_, err = PeriodFromRange(dateRange(UnboundedBound[LocalDate](), ClosedBound(date("2018-01-31"))))
+	assert.Equal(t, ErrPeriodInvalidParamNull, err)
+	_, err = PeriodFromRange(dateRange(ClosedBound(date("2018-01-31")), OpenBound(date("2018-01-31"))))
+	assert.Equal(t, ErrPeriodInvalidFromAfterTo, err)
+}
+
+func TestDateTimeSpanRange(t *testing.T) {
+	span := MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 12:00")
+	for _, s := range []DateTimeSpan{span, MustParseDateTimeSpan("2018-01-01 10:00/.."), MustParseDateTimeSpan("../2018-01-01 12:00")} {
+		converted, err := DateTimeSpanFromRange(s.Range())
+		assert.NoError(t, err)
+		assert.Equal(t, s, converted)
+	}
+	converted, err := DateTimeSpanFromRange(MustNewRange(ClosedBound(MustParseLocalDateTime("2018-01-01 10:00")),
+		ClosedBound(MustParseLocalDateTime("2018-01-01 11:59:59.999999999"))))
+	assert.NoError(t, err)
+	assert.Equal(t, span, converted)
+	assert.True(t, span.Range().Contains(MustParseLocalDateTime("2018-01-01 11:59:59.999999999")))
+	assert.False(t, span.Range().Contains(MustParseLocalDateTime("2018-01-01 12:00")))
+	_, err = DateTimeSpanFromRange(Range[LocalDateTime]{})
+	assert.Equal(t, ErrPeriodInvalidParamNull, err)
+}
+
+func TestLocalTimeSpanRange(t *testing.T) {
+	for _, value := range []string{"10:00-12:00", "10:00-00:00", "00:00-00:00"} {
+		span := MustParseTimeSpan(value)
+		converted, err := LocalTimeSpanFromRange(span.Range())
+		assert.NoError(t, err)
+		assert.Equal(t, span, converted, value)
+	}
+	converted, err := LocalTimeSpanFromRange(MustNewRange(OpenBound(MustParseLocalTime("09:59")), ClosedBound(MustParseLocalTime("23:59"))))
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseTimeSpan("10:00-00:00"), converted)
+
+	assert.True(t, MustParseTimeSpan("10:00-00:00").Overlaps(MustParseTimeSpan("22:00-00:00")))
+	assert.True(t, MustParseTimeSpan("22:00-00:00").Overlaps(MustParseTimeSpan("10:00-23:00")))
+	assert.False(t, MustParseTimeSpan("10:00-12:00").Overlaps(MustParseTimeSpan("12:00-00:00")))
+}
@@ -0,0 +1,144 @@
+package time
+
+// Relation returns which of Allen's interval relations holds between the span and other span,
+// e.g. RelationMeets for 10:00-11:00 and 11:00-12:00. Open span never ends.
+func (ts DateTimeSpan) Relation(other DateTimeSpan) IntervalRelation {
+	return ts.Range().Relation(other.Range())
+}
+
+// Before tells if span ends before other span starts, with a gap between them
+func (ts DateTimeSpan) Before(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationBefore
+}
+
+// After tells if span starts after other span ends, with a gap between them
+func (ts DateTimeSpan) After(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationAfter
+}
+
+// Meets tells if span ends exactly when other span starts
+func (ts DateTimeSpan) Meets(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationMeets
+}
+
+// MetBy tells if span starts exactly when other span ends
+func (ts DateTimeSpan) MetBy(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationMetBy
+}
+
+// OverlapsStartOf tells if span starts before other span and ends during it
+func (ts DateTimeSpan) OverlapsStartOf(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationOverlaps
+}
+
+// OverlapsEndOf tells if span starts during other span and ends after it
+func (ts DateTimeSpan) OverlapsEndOf(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationOverlappedBy
+}
+
+// Starts tells if spans start together and span ends first
+func (ts DateTimeSpan) Starts(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationStarts
+}
+
+// StartedBy tells if spans start together and other span ends first
+func (ts DateTimeSpan) StartedBy(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationStartedBy
+}
+
+// During tells if span starts after other span starts and ends before it ends
+func (ts DateTimeSpan) During(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationDuring
+}
+
+// ContainsStrictly tells if other span starts after span starts and ends before it ends
+func (ts DateTimeSpan) ContainsStrictly(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationContains
+}
+
+// Finishes tells if spans end together and span starts last
+func (ts DateTimeSpan) Finishes(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationFinishes
+}
+
+// FinishedBy tells if spans end together and other span starts last
+func (ts DateTimeSpan) FinishedBy(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationFinishedBy
+}
+
+// Equals tells if spans start and end together
+func (ts DateTimeSpan) Equals(other DateTimeSpan) bool {
+	return ts.Relation(other) == RelationEquals
+}
+
+// Relation returns which of Allen's interval relations holds between the period and other period.
+// Last days are inclusive, so a period meets the one starting on the day after its last day,
+// e.g. [2018-01-01 - 2018-01-31] meets [2018-02-01 - 2018-02-28]. Open period never ends.
+func (p Period) Relation(other Period) IntervalRelation {
+	return p.Range().Relation(other.Range())
+}
+
+// Before tells if period ends before other period starts, with at least a day between them
+func (p Period) Before(other Period) bool {
+	return p.Relation(other) == RelationBefore
+}
+
+// After tells if period starts after other period ends, with at least a day between them
+func (p Period) After(other Period) bool {
+	return p.Relation(other) == RelationAfter
+}
+
+// Meets tells if other period starts on the day after period ends
+func (p Period) Meets(other Period) bool {
+	return p.Relation(other) == RelationMeets
+}
+
+// MetBy tells if period starts on the day after other period ends
+func (p Period) MetBy(other Period) bool {
+	return p.Relation(other) == RelationMetBy
+}
+
+// OverlapsStartOf tells if period starts before other period and ends during it
+func (p Period) OverlapsStartOf(other Period) bool {
+	return p.Relation(other) == RelationOverlaps
+}
+
+// OverlapsEndOf tells if period starts during other period and ends after it
+func (p Period) OverlapsEndOf(other Period) bool {
+	return p.Relation(other) == RelationOverlappedBy
+}
+
+// Starts tells if periods start on the same day and period ends first
+func (p Period) Starts(other Period) bool {
+	return p.Relation(other) == RelationStarts
+}
+
+// StartedBy tells if periods start on the same day and other period ends first
+func (p Period) StartedBy(other Period) bool {
+	return p.Relation(other) == RelationStartedBy
+}
+
+// During tells if period starts after other period starts and ends before it ends
+func (p Period) During(other Period) bool {
+	return p.Relation(other) == RelationDuring
+}
+
+// ContainsStrictly tells if other period starts after period starts and ends before it ends
+func (p Period) ContainsStrictly(other Period) bool {
+	return p.Relation(other) == RelationContains
+}
+
+// Finishes tells if periods end on the same day and period starts last
+func (p Period) Finishes(other Period) bool {
+	return p.Relation(other) == RelationFinishes
+}
+
+// FinishedBy tells if periods end on the same day and other period starts last
+func (p Period) FinishedBy(other Period) bool {
+	return p.Relation(other) == RelationFinishedBy
+}
+
+// Equals tells if periods have the same days
+func (p Period) Equals(other Period) bool {
+	return p.Relation(other) == RelationEquals
+}
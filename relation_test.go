@@ -0,0 +1,178 @@
+package time
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dateTimeSpanPredicates = map[IntervalRelation]func(DateTimeSpan, DateTimeSpan) bool{
+	RelationBefore:       DateTimeSpan.Before,
+	RelationMeets:        DateTimeSpan.Meets,
+	RelationOverlaps:     DateTimeSpan.OverlapsStartOf,
+	RelationStarts:       DateTimeSpan.Starts,
+	RelationDuring:       DateTimeSpan.During,
+	RelationFinishes:     DateTimeSpan.Finishes,
+	RelationEquals:       DateTimeSpan.Equals,
+	RelationFinishedBy:   DateTimeSpan.FinishedBy,
+	RelationContains:     DateTimeSpan.ContainsStrictly,
+	RelationStartedBy:    DateTimeSpan.StartedBy,
+	RelationOverlappedBy: DateTimeSpan.OverlapsEndOf,
+	RelationMetBy:        DateTimeSpan.MetBy,
+	RelationAfter:        DateTimeSpan.After,
+}
+
+var periodPredicates = map[IntervalRelation]func(Period, Period) bool{
+	RelationBefore:       Period.Before,
+	RelationMeets:        Period.Meets,
+	RelationOverlaps:     Period.OverlapsStartOf,
+	RelationStarts:       Period.Starts,
+	RelationDuring:       Period.During,
+	RelationFinishes:     Period.Finishes,
+	RelationEquals:       Period.Equals,
+	RelationFinishedBy:   Period.FinishedBy,
+	RelationContains:     Period.ContainsStrictly,
+	RelationStartedBy:    Period.StartedBy,
+	RelationOverlappedBy: Period.OverlapsEndOf,
+	RelationMetBy:        Period.MetBy,
+	RelationAfter:        Period.After,
+}
+
+func TestDateTimeSpanRelation(t *testing.T) {
+	for _, tc := range []struct {
+		first, second string
+		relation      IntervalRelation
+	}{
+		{"2018-01-01 10:00/2018-01-01 11:00", "2018-01-01 12:00/2018-01-01 13:00", RelationBefore},
+		{"2018-01-01 10:00/2018-01-01 11:00", "2018-01-01 11:00/2018-01-01 13:00", RelationMeets},
+		{"2018-01-01 10:00/2018-01-01 12:00", "2018-01-01 11:00/2018-01-01 13:00", RelationOverlaps},
+		{"2018-01-01 10:00/2018-01-01 12:00", "2018-01-01 10:00/2018-01-01 13:00", RelationStarts},
+		{"2018-01-01 11:00/2018-01-01 12:00", "2018-01-01 10:00/2018-01-01 13:00", RelationDuring},
+		{"2018-01-01 11:00/2018-01-01 13:00", "2018-01-01 10:00/2018-01-01 13:00", RelationFinishes},
+		{"2018-01-01 10:00/2018-01-01 13:00", "2018-01-01 10:00/2018-01-01 13:00", RelationEquals},
+		{"2018-01-01 10:00/..", "2018-01-01 11:00/2018-01-01 13:00", RelationContains},
+		{"2018-01-01 10:00/..", "2018-01-01 09:00/2018-01-01 13:00", RelationOverlappedBy},
+		{"2018-01-01 10:00/..", "2018-01-01 09:00/2018-01-01 10:00", RelationMetBy},
+		{"2018-01-01 10:00/..", "2018-01-01 09:00/..", RelationFinishes},
+		{"2018-01-01 10:00/..", "2018-01-01 10:00/..", RelationEquals},
+	} {
+		first, second := MustParseDateTimeSpan(tc.first), MustParseDateTimeSpan(tc.second)
+		assert.Equal(t, tc.relation, first.Relation(second), "%v %v", tc.first, tc.second)
+		assert.Equal(t, tc.relation.Inverse(), second.Relation(first), "%v %v", tc.second, tc.first)
+		assert.True(t, dateTimeSpanPredicates[tc.relation](first, second))
+		assert.True(t, dateTimeSpanPredicates[tc.relation.Inverse()](second, first))
+	}
+}
+
+func TestPeriodRelation(t *testing.T) {
+	for _, tc := range []struct {
+		first, second string
+		relation      IntervalRelation
+	}{
+		{"2018-01-01/2018-01-30", "2018-02-01/2018-02-28", RelationBefore},
+		{"2018-01-01/2018-01-31", "2018-02-01/2018-02-28", RelationMeets},
+		{"2018-01-01/2018-02-01", "2018-02-01/2018-02-28", RelationOverlaps},
+		{"2018-01-01/2018-01-01", "2018-01-01/2018-01-31", RelationStarts},
+		{"2018-01-02/2018-01-30", "2018-01-01/2018-01-31", RelationDuring},
+		{"2018-01-31/2018-01-31", "2018-01-01/2018-01-31", RelationFinishes},
+		{"2018-01-01/2018-01-31", "2018-01-01/2018-01-31", RelationEquals},
+		{"2018-01-01/..", "2018-02-01/2018-02-28", RelationContains},
+		{"2018-02-01/..", "2018-01-01/2018-01-31", RelationMetBy},
+		{"2018-02-01/..", "2018-01-01/..", RelationFinishes},
+	} {
+		first, second := MustParsePeriod(tc.first), MustParsePeriod(tc.second)
+		assert.Equal(t, tc.relation, first.Relation(second), "%v %v", tc.first, tc.second)
+		assert.Equal(t, tc.relation.Inverse(), second.Relation(first), "%v %v", tc.second, tc.first)
+		assert.True(t, periodPredicates[tc.relation](first, second))
+		assert.True(t, periodPredicates[tc.relation.Inverse()](second, first))
+	}
+}
+
+// expectedRelation classifies half-open intervals [aFrom, aTo) and [bFrom, bTo) of integers
+// directly from the definitions of Allen's relations
+func expectedRelation(aFrom, aTo, bFrom, bTo int) IntervalRelation {
+	switch {
+	case aTo < bFrom:
+		return RelationBefore
+	case aTo == bFrom:
+		return RelationMeets
+	case bTo < aFrom:
+		return RelationAfter
+	case bTo == aFrom:
+		return RelationMetBy
+	case aFrom == bFrom && aTo == bTo:
+		return RelationEquals
+	case aFrom == bFrom && aTo < bTo:
+		return RelationStarts
+	case aFrom == bFrom:
+		return RelationStartedBy
+	case aTo == bTo && aFrom > bFrom:
+		return RelationFinishes
+	case aTo == bTo:
+		return RelationFinishedBy
+	case aFrom > bFrom && aTo < bTo:
+		return RelationDuring
+	case aFrom < bFrom && aTo > bTo:
+		return RelationContains
+	case aFrom < bFrom:
+		return RelationOverlaps
+	}
+	return RelationOverlappedBy
+}
+
+// unbounded stands for the end of an open interval in tests of relations
+const unbounded = 1000
+
+func TestDateTimeSpanRelationExhaustive(t *testing.T) {
+	start := MustParseLocalDateTime("2018-01-01 00:00")
+	type interval struct {
+		from, to int
+		span     DateTimeSpan
+	}
+	var intervals []interval
+	for from := 0; from < 6; from++ {
+		for to := from + 1; to < 6; to++ {
+			intervals = append(intervals, interval{from, to, NewDateTimeSpan(start.Add(Duration(from)*Hour), start.Add(Duration(to)*Hour))})
+		}
+		intervals = append(intervals, interval{from, unbounded, MustNewOpenDateTimeSpanFrom(start.Add(Duration(from) * Hour))})
+	}
+	seen := map[IntervalRelation]bool{}
+	for _, a := range intervals {
+		for _, b := range intervals {
+			expected := expectedRelation(a.from, a.to, b.from, b.to)
+			seen[expected] = true
+			assert.Equal(t, expected, a.span.Relation(b.span), "%v %v", a.span, b.span)
+			for relation, predicate := range dateTimeSpanPredicates {
+				assert.Equal(t, relation == expected, predicate(a.span, b.span), "%v %v %v", relation, a.span, b.span)
+			}
+		}
+	}
+	assert.Len(t, seen, 13)
+}
+
+func TestPeriodRelationExhaustive(t *testing.T) {
+	type interval struct {
+		from, to int
+		period   Period
+	}
+	var intervals []interval
+	for from := 1; from < 7; from++ {
+		for to := from; to < 7; to++ {
+			// last day is inclusive, so the interval ends on the next day
+			intervals = append(intervals, interval{from, to + 1, MustNewPeriod(NewLocalDate(2018, 1, from), NewLocalDate(2018, 1, to))})
+		}
+		intervals = append(intervals, interval{from, unbounded, MustNewOpenPeriodFrom(NewLocalDate(2018, 1, from))})
+	}
+	seen := map[IntervalRelation]bool{}
+	for _, a := range intervals {
+		for _, b := range intervals {
+			expected := expectedRelation(a.from, a.to, b.from, b.to)
+			seen[expected] = true
+			assert.Equal(t, expected, a.period.Relation(b.period), "%v %v", a.period, b.period)
+			for relation, predicate := range periodPredicates {
+				assert.Equal(t, relation == expected, predicate(a.period, b.period), "%v %v %v", relation, a.period, b.period)
+			}
+		}
+	}
+	assert.Len(t, seen, 13)
+}
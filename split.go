@@ -0,0 +1,121 @@
+package time
+
+import (
+	"errors"
+	"iter"
+	"slices"
+	"time"
+)
+
+// ErrSplitOpen is returned when open DateTimeSpan or Period is split, it must be bounded with Until first
+var ErrSplitOpen = errors.New("Open span or period must be bounded with Until before splitting")
+
+// ErrSplitNonPositiveDuration is returned when DateTimeSpan is split by duration which is not positive
+var ErrSplitNonPositiveDuration = errors.New("Span can be split only by positive duration")
+
+// SplitByDay splits span at midnights into ordered pieces, partial first and last ones included,
+// e.g. 2018-01-01 22:00/2018-01-02 02:00 into 22:00-00:00 and 00:00-02:00.
+// Open span must be bounded with Until first, otherwise ErrSplitOpen is returned.
+func (ts DateTimeSpan) SplitByDay() ([]DateTimeSpan, error) {
+	return ts.splitAt(func(ldt LocalDateTime) LocalDateTime {
+		return ldt.Date().End()
+	})
+}
+
+// SplitBy splits span into ordered pieces of given positive duration counted from its start,
+// the last piece may be shorter. Open span must be bounded with Until first, otherwise ErrSplitOpen is returned.
+func (ts DateTimeSpan) SplitBy(d Duration) ([]DateTimeSpan, error) {
+	if d <= 0 {
+		return nil, ErrSplitNonPositiveDuration
+	}
+	return ts.splitAt(func(ldt LocalDateTime) LocalDateTime {
+		return ldt.Add(d)
+	})
+}
+
+// splitAt splits span at boundaries, next returns the first boundary after given moment
+func (ts DateTimeSpan) splitAt(next func(LocalDateTime) LocalDateTime) ([]DateTimeSpan, error) {
+	if ts.IsOpen() {
+		return nil, ErrSplitOpen
+	}
+	return slices.Collect(ts.piecesAt(next)), nil
+}
+
+// piecesAt yields pieces of span between boundaries, indefinitely for an open span
+func (ts DateTimeSpan) piecesAt(next func(LocalDateTime) LocalDateTime) iter.Seq[DateTimeSpan] {
+	return func(yield func(DateTimeSpan) bool) {
+		from := ts.from
+		for boundary := next(from); ts.to.IsNull() || boundary.Before(ts.to); boundary = next(from) {
+			if !yield(DateTimeSpan{from: from, to: boundary}) {
+				return
+			}
+			from = boundary
+		}
+		yield(DateTimeSpan{from: from, to: ts.to})
+	}
+}
+
+// SplitByWeek splits period into ordered weeks starting on startDay, partial first and last ones included.
+// Open period must be bounded with Until first, otherwise ErrSplitOpen is returned.
+func (p Period) SplitByWeek(startDay Weekday) ([]Period, error) {
+	return p.splitAt(nextWeek(startDay))
+}
+
+// SplitByMonth splits period into ordered calendar months, partial first and last ones included.
+// Open period must be bounded with Until first, otherwise ErrSplitOpen is returned.
+func (p Period) SplitByMonth() ([]Period, error) {
+	return p.splitAt(nextMonth)
+}
+
+// SplitByQuarter splits period into ordered calendar quarters, partial first and last ones included.
+// Open period must be bounded with Until first, otherwise ErrSplitOpen is returned.
+func (p Period) SplitByQuarter() ([]Period, error) {
+	return p.splitAt(func(date LocalDate) LocalDate {
+		year, month, _ := date.Date()
+		return NewLocalDate(year, (month-1)/3*3+4, 1)
+	})
+}
+
+// SplitByYear splits period into ordered calendar years, partial first and last ones included.
+// Open period must be bounded with Until first, otherwise ErrSplitOpen is returned.
+func (p Period) SplitByYear() ([]Period, error) {
+	return p.splitAt(func(date LocalDate) LocalDate {
+		return NewLocalDate(date.Year()+1, time.January, 1)
+	})
+}
+
+// splitAt splits period before boundaries, next returns the first day of the next piece after given date
+func (p Period) splitAt(next func(LocalDate) LocalDate) ([]Period, error) {
+	if p.IsOpen() {
+		return nil, ErrSplitOpen
+	}
+	return slices.Collect(p.piecesAt(next)), nil
+}
+
+// piecesAt yields pieces of period between boundaries, indefinitely for an open period
+func (p Period) piecesAt(next func(LocalDate) LocalDate) iter.Seq[Period] {
+	return func(yield func(Period) bool) {
+		from := p.from
+		for boundary := next(from); p.IsOpen() || boundary.BeforeOrEqual(p.to); boundary = next(from) {
+			if !yield(Period{from: from, to: boundary.AddDate(0, 0, -1)}) {
+				return
+			}
+			from = boundary
+		}
+		yield(Period{from: from, to: p.to})
+	}
+}
+
+// nextWeek returns the first day after date which is startDay
+func nextWeek(startDay Weekday) func(LocalDate) LocalDate {
+	return func(date LocalDate) LocalDate {
+		days := (int(startDay) - int(date.Weekday()) + 6) % 7
+		return date.AddDate(0, 0, days+1)
+	}
+}
+
+// nextMonth returns the first day of the month after date
+func nextMonth(date LocalDate) LocalDate {
+	year, month, _ := date.Date()
+	return NewLocalDate(year, month+1, 1)
+}
@@ -0,0 +1,116 @@
+package time
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTimeSpanSplitByDay(t *testing.T) {
+	pieces, err := MustParseDateTimeSpan("2018-01-01 22:00/2018-01-03 02:30").SplitByDay()
+	assert.NoError(t, err)
+	assert.Equal(t, parseSpans("2018-01-01 22:00/2018-01-02 00:00", "2018-01-02 00:00/2018-01-03 00:00", "2018-01-03 00:00/2018-01-03 02:30"), pieces)
+	pieces, err = MustParseDateTimeSpan("2018-01-01 00:00/2018-01-02 00:00").SplitByDay()
+	assert.NoError(t, err)
+	assert.Equal(t, parseSpans("2018-01-01 00:00/2018-01-02 00:00"), pieces)
+	pieces, err = MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 10:00").SplitByDay()
+	assert.NoError(t, err)
+	assert.Equal(t, parseSpans("2018-01-01 10:00/2018-01-01 10:00"), pieces)
+}
+
+func TestDateTimeSpanSplitBy(t *testing.T) {
+	span := MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 12:00")
+	pieces, err := span.SplitBy(45 * Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, parseSpans("2018-01-01 10:00/2018-01-01 10:45", "2018-01-01 10:45/2018-01-01 11:30", "2018-01-01 11:30/2018-01-01 12:00"), pieces)
+
+	pieces, err = span.SplitBy(0)
+	assert.Equal(t, ErrSplitNonPositiveDuration, err)
+	assert.Nil(t, pieces)
+	_, err = span.SplitBy(-Hour)
+	assert.Equal(t, ErrSplitNonPositiveDuration, err)
+}
+
+func TestDateTimeSpanSplitOpen(t *testing.T) {
+	open := MustParseDateTimeSpan("2018-01-01 22:00/..")
+	pieces, err := open.SplitByDay()
+	assert.Equal(t, ErrSplitOpen, err)
+	assert.Nil(t, pieces)
+	pieces, err = open.SplitBy(Hour)
+	assert.Equal(t, ErrSplitOpen, err)
+	assert.Nil(t, pieces)
+	_, err = MustParseDateTimeSpan("../2018-01-01 10:00").SplitByDay()
+	assert.Equal(t, ErrSplitOpen, err)
+
+	bounded, err := open.Until(MustParseLocalDateTime("2018-01-02 06:00"))
+	assert.NoError(t, err)
+	pieces, err = bounded.SplitByDay()
+	assert.NoError(t, err)
+	assert.Equal(t, parseSpans("2018-01-01 22:00/2018-01-02 00:00", "2018-01-02 00:00/2018-01-02 06:00"), pieces)
+
+	_, err = open.Until(MustParseLocalDateTime("2018-01-01 21:00"))
+	assert.Equal(t, ErrPeriodInvalidFromAfterTo, err)
+	span := MustParseDateTimeSpan("2018-01-01 10:00/2018-01-01 12:00")
+	bounded, err = span.Until(MustParseLocalDateTime("2018-01-02 00:00"))
+	assert.NoError(t, err)
+	assert.Equal(t, span, bounded)
+}
+
+func TestPeriodSplitByWeek(t *testing.T) {
+	// 2018-01-03 is Wednesday
+	period := MustParsePeriod("2018-01-03/2018-01-16")
+	weeks, err := period.SplitByWeek(Monday)
+	assert.NoError(t, err)
+	assert.Equal(t, parsePeriods("2018-01-03/2018-01-07", "2018-01-08/2018-01-14", "2018-01-15/2018-01-16"), weeks)
+	weeks, err = period.SplitByWeek(Sunday)
+	assert.NoError(t, err)
+	assert.Equal(t, parsePeriods("2018-01-03/2018-01-06", "2018-01-07/2018-01-13", "2018-01-14/2018-01-16"), weeks)
+	weeks, err = period.SplitByWeek(Wednesday)
+	assert.NoError(t, err)
+	assert.Equal(t, parsePeriods("2018-01-03/2018-01-09", "2018-01-10/2018-01-16"), weeks)
+	weeks, err = MustParsePeriod("2018-01-03/2018-01-03").SplitByWeek(Monday)
+	assert.NoError(t, err)
+	assert.Equal(t, parsePeriods("2018-01-03/2018-01-03"), weeks)
+}
+
+func TestPeriodSplitByMonthQuarterYear(t *testing.T) {
+	period := MustParsePeriod("2017-11-15/2018-04-10")
+	pieces, err := period.SplitByMonth()
+	assert.NoError(t, err)
+	assert.Equal(t, parsePeriods("2017-11-15/2017-11-30", "2017-12-01/2017-12-31", "2018-01-01/2018-01-31",
+		"2018-02-01/2018-02-28", "2018-03-01/2018-03-31", "2018-04-01/2018-04-10"), pieces)
+	pieces, err = period.SplitByQuarter()
+	assert.NoError(t, err)
+	assert.Equal(t, parsePeriods("2017-11-15/2017-12-31", "2018-01-01/2018-03-31", "2018-04-01/2018-04-10"), pieces)
+	pieces, err = period.SplitByYear()
+	assert.NoError(t, err)
+	assert.Equal(t, parsePeriods("2017-11-15/2017-12-31", "2018-01-01/2018-04-10"), pieces)
+	pieces, err = MustParsePeriod("2018-01-01/2018-12-31").SplitByYear()
+	assert.NoError(t, err)
+	assert.Equal(t, parsePeriods("2018-01-01/2018-12-31"), pieces)
+}
+
+func TestPeriodSplitOpen(t *testing.T) {
+	open := MustParsePeriod("2018-01-15/..")
+	for _, split := range []func() ([]Period, error){
+		func() ([]Period, error) { return open.SplitByWeek(Monday) },
+		open.SplitByMonth, open.SplitByQuarter, open.SplitByYear,
+	} {
+		pieces, err := split()
+		assert.Equal(t, ErrSplitOpen, err)
+		assert.Nil(t, pieces)
+	}
+
+	bounded, err := open.Until(MustParseLocalDate("2018-03-10"))
+	assert.NoError(t, err)
+	pieces, err := bounded.SplitByMonth()
+	assert.NoError(t, err)
+	assert.Equal(t, parsePeriods("2018-01-15/2018-01-31", "2018-02-01/2018-02-28", "2018-03-01/2018-03-10"), pieces)
+
+	_, err = open.Until(MustParseLocalDate("2018-01-14"))
+	assert.Equal(t, ErrPeriodInvalidFromAfterTo, err)
+	period := MustParsePeriod("2018-01-15/2018-01-20")
+	bounded, err = period.Until(MustParseLocalDate("2018-02-01"))
+	assert.NoError(t, err)
+	assert.Equal(t, period, bounded)
+}
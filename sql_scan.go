@@ -0,0 +1,83 @@
+package time
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sqlTimestampFormats are formats in which drivers return date and time columns as text,
+// e.g. MySQL without parseTime, SQLite or PostgreSQL in text mode
+var sqlTimestampFormats = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	LocalDateFormat,
+}
+
+// sqlTimeFormats are formats in which drivers return time of day columns as text,
+// e.g. PostgreSQL time and timetz or MySQL TIME
+var sqlTimeFormats = []string{
+	"15:04:05.999999999Z07:00",
+	"15:04:05.999999999-07",
+	"15:04:05.999999999",
+	"15:04",
+}
+
+// scanText converts textual column value into string
+func scanText(typeName string, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", fmt.Errorf("sql: failed to scan into %v: column is NULL", typeName)
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		return "", fmt.Errorf("sql: failed to scan into %v: unsupported column type %T", typeName, value)
+	}
+}
+
+// scanTime converts column value returned as time.Time, string or []byte into time.Time
+// trying given formats in order
+func scanTime(typeName string, value interface{}, formats []string) (time.Time, error) {
+	if t, ok := value.(time.Time); ok {
+		return t, nil
+	}
+	text, err := scanText(typeName, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	text = strings.TrimSpace(text)
+	for _, format := range formats {
+		if t, err := time.Parse(format, text); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("sql: failed to scan into %v: unsupported format: %q", typeName, text)
+}
+
+// scanUnix converts column value returned as integer, its textual form or time.Time into integer,
+// counting given units for time.Time
+func scanUnix(typeName string, value interface{}, unit time.Duration) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case time.Time:
+		return unixTimestamp(v, unit), nil
+	}
+	text, err := scanText(typeName, value)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("sql: failed to scan into %v: %v", typeName, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,156 @@
+package time
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// echoDriver is an in-memory stand-in for SQL drivers such as SQLite:
+// a query returns single row with query arguments as columns,
+// so tests can control exact values returned by a driver.
+type echoDriver struct{}
+
+func (echoDriver) Open(name string) (driver.Conn, error) { return echoConn{}, nil }
+
+type echoConn struct{}
+
+func (echoConn) Prepare(query string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                              { return nil }
+func (echoConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type echoStmt struct{}
+
+func (echoStmt) Close() error  { return nil }
+func (echoStmt) NumInput() int { return -1 }
+func (echoStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{values: args}, nil
+}
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *echoRows) Columns() []string { return make([]string, len(r.values)) }
+func (r *echoRows) Close() error      { return nil }
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	copy(dest, r.values)
+	r.done = true
+	return nil
+}
+
+func init() {
+	sql.Register("echo", echoDriver{})
+}
+
+func scanFromDriver(t *testing.T, value interface{}, dest interface{}) error {
+	db, err := sql.Open("echo", "")
+	assert.NoError(t, err)
+	defer db.Close()
+	return db.QueryRow("SELECT ?", value).Scan(dest)
+}
+
+func TestScanLocalDateFromDrivers(t *testing.T) {
+	var tests = []struct {
+		driver string
+		value  interface{}
+	}{
+		{"pq", time.Date(2018, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"mysql without parseTime", []byte("2018-01-02")},
+		{"sqlite date", "2018-01-02"},
+		{"sqlite datetime", "2018-01-02 00:00:00"},
+		{"sqlite timestamp with zone", "2018-01-02 00:00:00+01:00"},
+		{"pgx text", "2018-01-02T00:00:00Z"},
+	}
+	for _, test := range tests {
+		var actual LocalDate
+		assert.NoError(t, scanFromDriver(t, test.value, &actual), test.driver)
+		assert.Equal(t, NewLocalDate(2018, 1, 2), actual, test.driver)
+	}
+
+	var actual LocalDate
+	assert.Error(t, scanFromDriver(t, "02.01.2018", &actual))
+	assert.Error(t, scanFromDriver(t, int64(20180102), &actual))
+	assert.Error(t, scanFromDriver(t, nil, &actual))
+}
+
+func TestScanLocalTimeFromDrivers(t *testing.T) {
+	var tests = []struct {
+		driver string
+		value  interface{}
+	}{
+		{"mysql with parseTime", time.Date(0, 1, 1, 23, 45, 12, 0, time.UTC)},
+		{"mysql without parseTime", []byte("23:45:12")},
+		{"postgres time", "23:45:12.345678"},
+		{"postgres timetz", "23:45:12+02"},
+		{"sqlite", "23:45"},
+		{"sqlite datetime", "2018-01-02 23:45:00"},
+	}
+	for _, test := range tests {
+		var actual LocalTime
+		assert.NoError(t, scanFromDriver(t, test.value, &actual), test.driver)
+		assert.Equal(t, MustCreateNewLocalTime(23, 45), actual, test.driver)
+	}
+
+	var actual LocalTime
+	assert.Error(t, scanFromDriver(t, "25:00", &actual))
+	assert.Error(t, scanFromDriver(t, nil, &actual))
+}
+
+func TestScanLocalDateTimeFromDrivers(t *testing.T) {
+	expected := NewLocalDateTime(time.Date(2018, 1, 2, 15, 4, 5, 123456000, time.UTC))
+	var tests = []struct {
+		driver string
+		value  interface{}
+	}{
+		{"pq", time.Date(2018, 1, 2, 15, 4, 5, 123456000, time.UTC)},
+		{"mysql without parseTime", []byte("2018-01-02 15:04:05.123456")},
+		{"sqlite", "2018-01-02 15:04:05.123456"},
+		{"sqlite with zone", "2018-01-02 15:04:05.123456+01:00"},
+		{"sqlite iso", "2018-01-02T15:04:05.123456Z"},
+		{"postgres timestamptz text", "2018-01-02 15:04:05.123456+01"},
+	}
+	for _, test := range tests {
+		var actual LocalDateTime
+		assert.NoError(t, scanFromDriver(t, test.value, &actual), test.driver)
+		assert.Equal(t, expected, actual, test.driver)
+	}
+
+	var actual LocalDateTime
+	assert.Error(t, scanFromDriver(t, "yesterday", &actual))
+	assert.Error(t, scanFromDriver(t, nil, &actual))
+}
+
+func TestScanUnixTimeStampFromDrivers(t *testing.T) {
+	var tests = []struct {
+		driver string
+		value  interface{}
+	}{
+		{"sqlite", int64(1514905445)},
+		{"mysql without binary protocol", []byte("1514905445")},
+		{"text", "1514905445"},
+		{"timestamp", time.Date(2018, 1, 2, 15, 4, 5, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		var actual UnixTimeStamp
+		assert.NoError(t, scanFromDriver(t, test.value, &actual), test.driver)
+		assert.Equal(t, UnixTimeStamp(1514905445), actual, test.driver)
+	}
+
+	var actual UnixTimeStamp
+	assert.Error(t, actual.Scan(3.14))
+	assert.Error(t, actual.Scan("now"))
+	assert.Error(t, actual.Scan(nil))
+}
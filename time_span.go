@@ -1,6 +1,8 @@
 package time
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -30,16 +32,7 @@ func (ts LocalTimeSpan) OverlapsDateTimeSpan(dateTimeSpan DateTimeSpan) bool {
 
 // Overlaps checks if LocalTimeSpan overlaps other LocalTimeSpan
 func (ts LocalTimeSpan) Overlaps(other LocalTimeSpan) bool {
-
-	if ts.to == Midnight && ts.from.Before(other.to) {
-		return true
-	}
-
-	if !ts.from.Before(other.to) || !ts.to.After(other.from) {
-		return false
-	}
-
-	return true
+	return ts.Range().Overlaps(other.Range())
 }
 
 // Contains check if LocalTimeSpan contains DateTimeSpan
@@ -72,12 +65,112 @@ func (ts LocalTimeSpan) DateTimeSpanWithinOneDay(date LocalDate) DateTimeSpan {
 	return NewDateTimeSpan(start, end)
 }
 
+// ParseTimeSpan parses string in form of "15:04-15:04" into LocalTimeSpan
+func ParseTimeSpan(value string) (LocalTimeSpan, error) {
+	times := strings.Split(value, "-")
+	if len(times) != 2 {
+		return LocalTimeSpan{from: NullLocalTime, to: NullLocalTime}, fmt.Errorf("Wrong LocalTimeSpan format: %v", value)
+	}
+	from, err := ParseLocalTime(times[0])
+	if err != nil {
+		return LocalTimeSpan{from: NullLocalTime, to: NullLocalTime}, err
+	}
+	to, err := ParseLocalTime(times[1])
+	if err != nil {
+		return LocalTimeSpan{from: NullLocalTime, to: NullLocalTime}, err
+	}
+	return newValidTimeSpan(from, to)
+}
+
 // MustParseTimeSpan parses string in form of "15:04-15:04" into LocalTimeSpan
 func MustParseTimeSpan(value string) LocalTimeSpan {
-	times := strings.Split(value, "-")
-	from := MustParseLocalTime(times[0])
-	to := MustParseLocalTime(times[1])
-	return NewTimeSpan(from, to)
+	timeSpan, err := ParseTimeSpan(value)
+	if err != nil {
+		panic(err)
+	}
+	return timeSpan
+}
+
+// newValidTimeSpan is like NewTimeSpan but returns an error instead of panicking
+func newValidTimeSpan(from LocalTime, to LocalTime) (LocalTimeSpan, error) {
+	if from == NullLocalTime || to == NullLocalTime {
+		return LocalTimeSpan{from: NullLocalTime, to: NullLocalTime}, ErrPeriodInvalidParamNull
+	}
+	if to != Midnight && from.After(to) {
+		return LocalTimeSpan{from: NullLocalTime, to: NullLocalTime}, ErrPeriodInvalidFromAfterTo
+	}
+	return LocalTimeSpan{from: from, to: to}, nil
+}
+
+func (ts LocalTimeSpan) String() string {
+	return ts.from.String() + "-" + ts.to.String()
+}
+
+// MarshalText serializes span to string in form of "15:04-15:04"
+func (ts LocalTimeSpan) MarshalText() ([]byte, error) {
+	return []byte(ts.String()), nil
+}
+
+// UnmarshalText parses string into span using ParseTimeSpan
+func (ts *LocalTimeSpan) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return nil
+	}
+	timeSpan, err := ParseTimeSpan(string(text))
+	if err != nil {
+		return err
+	}
+	*ts = timeSpan
+	return nil
+}
+
+type jsonTimeSpan struct {
+	From *LocalTime `json:"from"`
+	To   *LocalTime `json:"to"`
+}
+
+// MarshalJSON marshals span to JSON object
+func (ts LocalTimeSpan) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonTimeSpan{From: &ts.from, To: &ts.to})
+}
+
+// UnmarshalJSON parses JSON object into span
+func (ts *LocalTimeSpan) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var jts jsonTimeSpan
+	if err := json.Unmarshal(data, &jts); err != nil {
+		return err
+	}
+	if jts.From == nil || jts.To == nil {
+		return ErrPeriodInvalidParamNull
+	}
+	timeSpan, err := newValidTimeSpan(*jts.From, *jts.To)
+	if err != nil {
+		return err
+	}
+	*ts = timeSpan
+	return nil
+}
+
+// Scan implements the Scanner interface.
+func (ts *LocalTimeSpan) Scan(value interface{}) error {
+	text, err := scanText("LocalTimeSpan", value)
+	if err != nil {
+		return err
+	}
+	timeSpan, err := ParseTimeSpan(text)
+	if err != nil {
+		return err
+	}
+	*ts = timeSpan
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+func (ts LocalTimeSpan) Value() (driver.Value, error) {
+	return ts.String(), nil
 }
 
 func (ts LocalTimeSpan) Duration() Duration {
@@ -2,6 +2,8 @@ package time
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestContains(t *testing.T) {
@@ -88,3 +90,36 @@ type TimeSpanTest struct {
 	datetimeEnd   string
 	want          bool
 }
+
+func TestLocalTimeSpanJSON(t *testing.T) {
+	timeSpan := MustParseTimeSpan("10:00-00:00")
+	JSON, err := timeSpan.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"from":"10:00","to":"00:00"}`, string(JSON))
+
+	var actual LocalTimeSpan
+	assert.NoError(t, actual.UnmarshalJSON(JSON))
+	assert.Equal(t, timeSpan, actual)
+
+	assert.Equal(t, ErrPeriodInvalidFromAfterTo, actual.UnmarshalJSON([]byte(`{"from":"11:00","to":"10:00"}`)))
+	assert.Equal(t, ErrPeriodInvalidParamNull, actual.UnmarshalJSON([]byte(`{"from":"11:00"}`)))
+}
+
+func TestLocalTimeSpanTextScanAndValue(t *testing.T) {
+	text, _ := MustParseTimeSpan("10:00-11:22").MarshalText()
+	assert.Equal(t, "10:00-11:22", string(text))
+
+	var actual LocalTimeSpan
+	assert.NoError(t, actual.UnmarshalText([]byte("10:00-11:22")))
+	assert.Equal(t, MustParseTimeSpan("10:00-11:22"), actual)
+	assert.Error(t, actual.UnmarshalText([]byte("10:00")))
+	assert.Error(t, actual.UnmarshalText([]byte("12:00-11:22")))
+
+	assert.NoError(t, actual.Scan([]byte("08:15-09:45")))
+	assert.Equal(t, MustParseTimeSpan("08:15-09:45"), actual)
+	assert.Error(t, actual.Scan(nil))
+
+	value, err := actual.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "08:15-09:45", value)
+}
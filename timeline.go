@@ -0,0 +1,283 @@
+package time
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+// ErrTimelineOverlap is returned when value is inserted into timeline with TimelineReject policy
+// over a period or span which already has a value
+var ErrTimelineOverlap = errors.New("Timeline already has a value over inserted period")
+
+// TimelinePolicy decides what happens when value is inserted into timeline over days or moments which already have a value
+type TimelinePolicy int
+
+const (
+	// TimelineOverwrite makes inserted value replace existing values on common days or moments,
+	// periods or spans of existing values are cut or split around the inserted one
+	TimelineOverwrite TimelinePolicy = iota
+	// TimelineSplit keeps existing values, inserted value is split to fill only days or moments without a value
+	TimelineSplit
+	// TimelineReject keeps existing values and rejects inserted value with ErrTimelineOverlap
+	TimelineReject
+)
+
+// TimelineEntry is a value effective over a period in Timeline
+type TimelineEntry[T comparable] struct {
+	Period Period `json:"period"`
+	Value  T      `json:"value"`
+}
+
+// Timeline keeps values effective over periods, e.g. prices or tax rates which change over time.
+// Periods of values never overlap, inserting a value over days which already have one is resolved
+// with TimelinePolicy. Timeline is not safe for concurrent use.
+// Zero value is an empty timeline with TimelineOverwrite policy.
+type Timeline[T comparable] struct {
+	// spans keeps periods as spans of their days, from the start of the first day to the start of the day after
+	spans SpanTimeline[T]
+}
+
+// NewTimeline creates empty Timeline resolving overlaps on insert with given policy
+func NewTimeline[T comparable](policy TimelinePolicy) *Timeline[T] {
+	return &Timeline[T]{spans: SpanTimeline[T]{policy: policy}}
+}
+
+// Policy returns policy of resolving overlaps on insert
+func (tl Timeline[T]) Policy() TimelinePolicy {
+	return tl.spans.policy
+}
+
+// Insert makes value effective over period, overlaps with existing values are resolved with policy of the timeline.
+// It returns ErrTimelineOverlap when policy is TimelineReject and period overlaps any existing value.
+func (tl *Timeline[T]) Insert(period Period, value T) error {
+	return tl.spans.Insert(period.ToDateTimeSpan(), value)
+}
+
+// Entries returns values of the timeline with their periods in order
+func (tl Timeline[T]) Entries() []TimelineEntry[T] {
+	return periodEntries(tl.spans.Entries())
+}
+
+// Len returns the number of entries in the timeline
+func (tl Timeline[T]) Len() int {
+	return tl.spans.Len()
+}
+
+// ValueAt returns value effective on given date, false when there is none
+func (tl Timeline[T]) ValueAt(date LocalDate) (T, bool) {
+	return tl.spans.ValueAt(date.Start())
+}
+
+// Changes returns values effective on any day of given period in order, with periods clipped to it
+func (tl Timeline[T]) Changes(within Period) []TimelineEntry[T] {
+	return periodEntries(tl.spans.Changes(within.ToDateTimeSpan()))
+}
+
+// Compact merges adjacent entries with equal values,
+// e.g. the same price in [2018-01-01 - 2018-01-31] and [2018-02-01 - 2018-02-28]
+func (tl *Timeline[T]) Compact() {
+	tl.spans.Compact()
+}
+
+// MarshalJSON marshals timeline to JSON array of entries with "period" and "value"
+func (tl Timeline[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tl.Entries())
+}
+
+// UnmarshalJSON parses JSON array of entries into timeline, inserting them with its policy.
+// Policy itself is not serialized.
+func (tl *Timeline[T]) UnmarshalJSON(data []byte) error {
+	var entries []TimelineEntry[T]
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	parsed := Timeline[T]{spans: SpanTimeline[T]{policy: tl.spans.policy}}
+	for _, entry := range entries {
+		if err := parsed.Insert(entry.Period, entry.Value); err != nil {
+			return err
+		}
+	}
+	*tl = parsed
+	return nil
+}
+
+// periodEntries converts entries of whole days back to periods, nil stays nil
+func periodEntries[T comparable](entries []SpanTimelineEntry[T]) []TimelineEntry[T] {
+	if entries == nil {
+		return nil
+	}
+	periods := make([]TimelineEntry[T], 0, len(entries))
+	for _, entry := range entries {
+		period := Period{from: entry.Span.from.Date(), to: NullLocalDate}
+		if !entry.Span.to.IsNull() {
+			period.to = entry.Span.to.Date().AddDate(0, 0, -1)
+		}
+		periods = append(periods, TimelineEntry[T]{Period: period, Value: entry.Value})
+	}
+	return periods
+}
+
+// SpanTimelineEntry is a value effective over a span in SpanTimeline
+type SpanTimelineEntry[T comparable] struct {
+	Span  DateTimeSpan `json:"span"`
+	Value T            `json:"value"`
+}
+
+// SpanTimeline keeps values effective over DateTimeSpans, e.g. shift rates which change at given hours.
+// Spans of values never overlap, inserting a value over moments which already have one is resolved
+// with TimelinePolicy. Empty spans are ignored. SpanTimeline is not safe for concurrent use.
+// Zero value is an empty timeline with TimelineOverwrite policy.
+type SpanTimeline[T comparable] struct {
+	policy  TimelinePolicy
+	entries []SpanTimelineEntry[T]
+}
+
+// NewSpanTimeline creates empty SpanTimeline resolving overlaps on insert with given policy
+func NewSpanTimeline[T comparable](policy TimelinePolicy) *SpanTimeline[T] {
+	return &SpanTimeline[T]{policy: policy}
+}
+
+// Policy returns policy of resolving overlaps on insert
+func (tl SpanTimeline[T]) Policy() TimelinePolicy {
+	return tl.policy
+}
+
+// Insert makes value effective over span, overlaps with existing values are resolved with policy of the timeline.
+// It returns ErrTimelineOverlap when policy is TimelineReject and span overlaps any existing value.
+func (tl *SpanTimeline[T]) Insert(span DateTimeSpan, value T) error {
+	if span.Empty() {
+		return nil
+	}
+	inserted := SpanTimelineEntry[T]{Span: span, Value: value}
+	first, last := tl.overlapping(span)
+	if first == last {
+		tl.entries = insertEntries(tl.entries, first, last, inserted)
+		return nil
+	}
+	overlapped := tl.entries[first:last]
+	var replacement []SpanTimelineEntry[T]
+	switch tl.policy {
+	case TimelineReject:
+		return ErrTimelineOverlap
+	case TimelineSplit:
+		gaps := NewDateTimeSpanSet(span)
+		for _, entry := range overlapped {
+			gaps = gaps.Remove(entry.Span)
+			replacement = append(replacement, entry)
+		}
+		for _, gap := range gaps.spans {
+			replacement = append(replacement, SpanTimelineEntry[T]{Span: gap, Value: value})
+		}
+		sort.Slice(replacement, func(i, j int) bool {
+			return replacement[j].Span.from.After(replacement[i].Span.from)
+		})
+	default:
+		// only the first overlapped entry may start before span and only the last one may end after it
+		var after []SpanTimelineEntry[T]
+		for _, entry := range overlapped {
+			for _, piece := range entry.Span.Subtract(span) {
+				if piece.from.After(span.from) {
+					after = append(after, SpanTimelineEntry[T]{Span: piece, Value: entry.Value})
+				} else {
+					replacement = append(replacement, SpanTimelineEntry[T]{Span: piece, Value: entry.Value})
+				}
+			}
+		}
+		replacement = append(append(replacement, inserted), after...)
+	}
+	tl.entries = insertEntries(tl.entries, first, last, replacement...)
+	return nil
+}
+
+// overlapping returns range of indexes of entries overlapping span
+func (tl SpanTimeline[T]) overlapping(span DateTimeSpan) (int, int) {
+	first := tl.search(span.from)
+	last := first
+	for last < len(tl.entries) && tl.entries[last].Span.Overlaps(span) {
+		last++
+	}
+	return first, last
+}
+
+// search returns index of the first entry which ends after ldt
+func (tl SpanTimeline[T]) search(ldt LocalDateTime) int {
+	return sort.Search(len(tl.entries), func(i int) bool {
+		return !spanEndsBy(tl.entries[i].Span, ldt)
+	})
+}
+
+// insertEntries replaces entries[first:last] with given ones
+func insertEntries[T comparable](entries []SpanTimelineEntry[T], first, last int, replacement ...SpanTimelineEntry[T]) []SpanTimelineEntry[T] {
+	result := make([]SpanTimelineEntry[T], 0, len(entries)-(last-first)+len(replacement))
+	result = append(result, entries[:first]...)
+	result = append(result, replacement...)
+	return append(result, entries[last:]...)
+}
+
+// Entries returns values of the timeline with their spans in order
+func (tl SpanTimeline[T]) Entries() []SpanTimelineEntry[T] {
+	return append(make([]SpanTimelineEntry[T], 0, len(tl.entries)), tl.entries...)
+}
+
+// Len returns the number of entries in the timeline
+func (tl SpanTimeline[T]) Len() int {
+	return len(tl.entries)
+}
+
+// ValueAt returns value effective at given moment, false when there is none
+func (tl SpanTimeline[T]) ValueAt(ldt LocalDateTime) (T, bool) {
+	i := tl.search(ldt)
+	if i < len(tl.entries) && !tl.entries[i].Span.from.After(ldt) {
+		return tl.entries[i].Value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Changes returns values effective at any moment of given span in order, with spans clipped to it
+func (tl SpanTimeline[T]) Changes(within DateTimeSpan) []SpanTimelineEntry[T] {
+	first, last := tl.overlapping(within)
+	var changes []SpanTimelineEntry[T]
+	for _, entry := range tl.entries[first:last] {
+		clipped, _ := entry.Span.Clip(within)
+		changes = append(changes, SpanTimelineEntry[T]{Span: clipped, Value: entry.Value})
+	}
+	return changes
+}
+
+// Compact merges entries with equal values where one ends when the other starts
+func (tl *SpanTimeline[T]) Compact() {
+	var result []SpanTimelineEntry[T]
+	for _, entry := range tl.entries {
+		if last := len(result) - 1; last >= 0 && result[last].Value == entry.Value &&
+			!result[last].Span.to.IsNull() && result[last].Span.to == entry.Span.from {
+			result[last].Span.to = entry.Span.to
+			continue
+		}
+		result = append(result, entry)
+	}
+	tl.entries = result
+}
+
+// MarshalJSON marshals timeline to JSON array of entries with "span" and "value"
+func (tl SpanTimeline[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tl.Entries())
+}
+
+// UnmarshalJSON parses JSON array of entries into timeline, inserting them with its policy.
+// Policy itself is not serialized.
+func (tl *SpanTimeline[T]) UnmarshalJSON(data []byte) error {
+	var entries []SpanTimelineEntry[T]
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	parsed := SpanTimeline[T]{policy: tl.policy}
+	for _, entry := range entries {
+		if err := parsed.Insert(entry.Span, entry.Value); err != nil {
+			return err
+		}
+	}
+	*tl = parsed
+	return nil
+}
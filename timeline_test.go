@@ -0,0 +1,240 @@
+package time
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func timelineEntry(period string, value int) TimelineEntry[int] {
+	return TimelineEntry[int]{Period: MustParsePeriod(period), Value: value}
+}
+
+func timelineOf(policy TimelinePolicy) *Timeline[int] {
+	tl := NewTimeline[int](policy)
+	tl.Insert(MustParsePeriod("2018-01-01/2018-01-31"), 10)
+	tl.Insert(MustParsePeriod("2018-03-01/2018-03-31"), 30)
+	tl.Insert(MustParsePeriod("2018-02-01/2018-02-28"), 20)
+	return tl
+}
+
+func TestTimelineInsertOverwrite(t *testing.T) {
+	tl := timelineOf(TimelineOverwrite)
+	assert.Equal(t, []TimelineEntry[int]{
+		timelineEntry("2018-01-01/2018-01-31", 10),
+		timelineEntry("2018-02-01/2018-02-28", 20),
+		timelineEntry("2018-03-01/2018-03-31", 30),
+	}, tl.Entries())
+
+	assert.NoError(t, tl.Insert(MustParsePeriod("2018-01-10/2018-01-20"), 15))
+	assert.NoError(t, tl.Insert(MustParsePeriod("2018-02-15/2018-03-10"), 25))
+	assert.NoError(t, tl.Insert(MustParsePeriod("2018-03-31/.."), 35))
+	assert.Equal(t, []TimelineEntry[int]{
+		timelineEntry("2018-01-01/2018-01-09", 10),
+		timelineEntry("2018-01-10/2018-01-20", 15),
+		timelineEntry("2018-01-21/2018-01-31", 10),
+		timelineEntry("2018-02-01/2018-02-14", 20),
+		timelineEntry("2018-02-15/2018-03-10", 25),
+		timelineEntry("2018-03-11/2018-03-30", 30),
+		timelineEntry("2018-03-31/..", 35),
+	}, tl.Entries())
+
+	assert.NoError(t, tl.Insert(MustParsePeriod("2017-12-01/2018-03-15"), 5))
+	assert.Equal(t, []TimelineEntry[int]{
+		timelineEntry("2017-12-01/2018-03-15", 5),
+		timelineEntry("2018-03-16/2018-03-30", 30),
+		timelineEntry("2018-03-31/..", 35),
+	}, tl.Entries())
+}
+
+func TestTimelineInsertSplit(t *testing.T) {
+	tl := timelineOf(TimelineSplit)
+	assert.NoError(t, tl.Insert(MustParsePeriod("2018-02-01/2018-02-28"), 0))
+	assert.NoError(t, tl.Insert(MustParsePeriod("2017-12-15/2018-04-15"), 5))
+	assert.Equal(t, []TimelineEntry[int]{
+		timelineEntry("2017-12-15/2017-12-31", 5),
+		timelineEntry("2018-01-01/2018-01-31", 10),
+		timelineEntry("2018-02-01/2018-02-28", 20),
+		timelineEntry("2018-03-01/2018-03-31", 30),
+		timelineEntry("2018-04-01/2018-04-15", 5),
+	}, tl.Entries())
+}
+
+func TestTimelineInsertReject(t *testing.T) {
+	tl := timelineOf(TimelineReject)
+	assert.Equal(t, ErrTimelineOverlap, tl.Insert(MustParsePeriod("2018-03-31/.."), 40))
+	assert.NoError(t, tl.Insert(MustParsePeriod("2018-04-01/.."), 40))
+	assert.Equal(t, 4, tl.Len())
+	assert.Equal(t, TimelineReject, tl.Policy())
+}
+
+func TestTimelineValueAt(t *testing.T) {
+	tl := timelineOf(TimelineOverwrite)
+	tl.Insert(MustParsePeriod("2018-05-01/.."), 50)
+	for date, expected := range map[string]int{
+		"2018-01-01": 10,
+		"2018-01-31": 10,
+		"2018-02-01": 20,
+		"2018-03-31": 30,
+		"2030-01-01": 50,
+	} {
+		value, ok := tl.ValueAt(MustParseLocalDate(date))
+		assert.True(t, ok, date)
+		assert.Equal(t, expected, value, date)
+	}
+	for _, date := range []string{"2017-12-31", "2018-04-01", "2018-04-30"} {
+		_, ok := tl.ValueAt(MustParseLocalDate(date))
+		assert.False(t, ok, date)
+	}
+	_, ok := Timeline[int]{}.ValueAt(MustParseLocalDate("2018-01-01"))
+	assert.False(t, ok)
+}
+
+func TestTimelineChanges(t *testing.T) {
+	tl := timelineOf(TimelineOverwrite)
+	assert.Equal(t, []TimelineEntry[int]{
+		timelineEntry("2018-01-15/2018-01-31", 10),
+		timelineEntry("2018-02-01/2018-02-28", 20),
+		timelineEntry("2018-03-01/2018-03-10", 30),
+	}, tl.Changes(MustParsePeriod("2018-01-15/2018-03-10")))
+	assert.Equal(t, []TimelineEntry[int]{timelineEntry("2018-03-20/2018-03-31", 30)},
+		tl.Changes(MustParsePeriod("2018-03-20/..")))
+	assert.Nil(t, tl.Changes(MustParsePeriod("2018-04-01/2018-04-30")))
+}
+
+func TestTimelineCompact(t *testing.T) {
+	tl := NewTimeline[int](TimelineOverwrite)
+	tl.Insert(MustParsePeriod("2018-01-01/2018-01-31"), 10)
+	tl.Insert(MustParsePeriod("2018-02-01/2018-02-28"), 10)
+	tl.Insert(MustParsePeriod("2018-03-01/2018-03-31"), 20)
+	tl.Insert(MustParsePeriod("2018-04-02/2018-04-30"), 20)
+	tl.Insert(MustParsePeriod("2018-05-01/.."), 20)
+	tl.Compact()
+	assert.Equal(t, []TimelineEntry[int]{
+		timelineEntry("2018-01-01/2018-02-28", 10),
+		timelineEntry("2018-03-01/2018-03-31", 20),
+		timelineEntry("2018-04-02/..", 20),
+	}, tl.Entries())
+}
+
+func TestTimelineJSON(t *testing.T) {
+	tl := timelineOf(TimelineOverwrite)
+	data, err := json.Marshal(tl)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"period":{"from":"2018-01-01","to":"2018-01-31"},"value":10},`+
+		`{"period":{"from":"2018-02-01","to":"2018-02-28"},"value":20},`+
+		`{"period":{"from":"2018-03-01","to":"2018-03-31"},"value":30}]`, string(data))
+
+	parsed := NewTimeline[int](TimelineReject)
+	assert.NoError(t, json.Unmarshal(data, parsed))
+	assert.Equal(t, tl.Entries(), parsed.Entries())
+	assert.Equal(t, TimelineReject, parsed.Policy())
+
+	assert.Equal(t, ErrTimelineOverlap, json.Unmarshal(
+		[]byte(`[{"period":{"from":"2018-01-01","to":null},"value":1},{"period":{"from":"2018-02-01","to":null},"value":2}]`), parsed))
+	assert.Equal(t, 3, parsed.Len())
+
+	held := struct{ TL Timeline[int] }{*tl}
+	data, err = json.Marshal(held)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"TL":[{"period":{"from":"2018-01-01","to":"2018-01-31"},"value":10},`+
+		`{"period":{"from":"2018-02-01","to":"2018-02-28"},"value":20},`+
+		`{"period":{"from":"2018-03-01","to":"2018-03-31"},"value":30}]}`, string(data))
+}
+
+func spanTimelineEntry(span string, value int) SpanTimelineEntry[int] {
+	return SpanTimelineEntry[int]{Span: MustParseDateTimeSpan(span), Value: value}
+}
+
+func spanTimelineOf(policy TimelinePolicy) *SpanTimeline[int] {
+	tl := NewSpanTimeline[int](policy)
+	tl.Insert(MustParseDateTimeSpan("2018-01-01 06:00/2018-01-01 14:00"), 10)
+	tl.Insert(MustParseDateTimeSpan("2018-01-01 22:00/2018-01-02 06:00"), 30)
+	tl.Insert(MustParseDateTimeSpan("2018-01-01 14:00/2018-01-01 22:00"), 20)
+	return tl
+}
+
+func TestSpanTimelineInsertOverwrite(t *testing.T) {
+	tl := spanTimelineOf(TimelineOverwrite)
+	assert.NoError(t, tl.Insert(MustParseDateTimeSpan("2018-01-01 08:00/2018-01-01 09:00"), 15))
+	assert.NoError(t, tl.Insert(MustParseDateTimeSpan("2018-01-01 21:00/2018-01-01 23:00"), 25))
+	assert.NoError(t, tl.Insert(MustParseDateTimeSpan("2018-01-02 05:00/.."), 35))
+	assert.NoError(t, tl.Insert(MustParseDateTimeSpan("../2018-01-01 07:00"), 5))
+	assert.NoError(t, tl.Insert(MustParseDateTimeSpan("2018-01-01 12:00/2018-01-01 12:00"), 0))
+	assert.Equal(t, []SpanTimelineEntry[int]{
+		spanTimelineEntry("../2018-01-01 07:00", 5),
+		spanTimelineEntry("2018-01-01 07:00/2018-01-01 08:00", 10),
+		spanTimelineEntry("2018-01-01 08:00/2018-01-01 09:00", 15),
+		spanTimelineEntry("2018-01-01 09:00/2018-01-01 14:00", 10),
+		spanTimelineEntry("2018-01-01 14:00/2018-01-01 21:00", 20),
+		spanTimelineEntry("2018-01-01 21:00/2018-01-01 23:00", 25),
+		spanTimelineEntry("2018-01-01 23:00/2018-01-02 05:00", 30),
+		spanTimelineEntry("2018-01-02 05:00/..", 35),
+	}, tl.Entries())
+}
+
+func TestSpanTimelineInsertSplit(t *testing.T) {
+	tl := spanTimelineOf(TimelineSplit)
+	assert.NoError(t, tl.Insert(MustParseDateTimeSpan("2018-01-01 00:00/.."), 5))
+	assert.Equal(t, []SpanTimelineEntry[int]{
+		spanTimelineEntry("2018-01-01 00:00/2018-01-01 06:00", 5),
+		spanTimelineEntry("2018-01-01 06:00/2018-01-01 14:00", 10),
+		spanTimelineEntry("2018-01-01 14:00/2018-01-01 22:00", 20),
+		spanTimelineEntry("2018-01-01 22:00/2018-01-02 06:00", 30),
+		spanTimelineEntry("2018-01-02 06:00/..", 5),
+	}, tl.Entries())
+}
+
+func TestSpanTimelineInsertReject(t *testing.T) {
+	tl := spanTimelineOf(TimelineReject)
+	assert.Equal(t, ErrTimelineOverlap, tl.Insert(MustParseDateTimeSpan("../2018-01-01 06:01"), 0))
+	assert.NoError(t, tl.Insert(MustParseDateTimeSpan("../2018-01-01 06:00"), 0))
+	assert.NoError(t, tl.Insert(MustParseDateTimeSpan("2018-01-02 06:00/.."), 40))
+	assert.Equal(t, 5, tl.Len())
+	assert.Equal(t, TimelineReject, tl.Policy())
+}
+
+func TestSpanTimelineQueries(t *testing.T) {
+	tl := spanTimelineOf(TimelineOverwrite)
+	for ldt, expected := range map[string]int{
+		"2018-01-01 06:00": 10,
+		"2018-01-01 13:59": 10,
+		"2018-01-01 14:00": 20,
+		"2018-01-02 05:59": 30,
+	} {
+		value, ok := tl.ValueAt(MustParseLocalDateTime(ldt))
+		assert.True(t, ok, ldt)
+		assert.Equal(t, expected, value, ldt)
+	}
+	_, ok := tl.ValueAt(MustParseLocalDateTime("2018-01-02 06:00"))
+	assert.False(t, ok)
+
+	assert.Equal(t, []SpanTimelineEntry[int]{
+		spanTimelineEntry("2018-01-01 12:00/2018-01-01 14:00", 10),
+		spanTimelineEntry("2018-01-01 14:00/2018-01-01 22:00", 20),
+		spanTimelineEntry("2018-01-01 22:00/2018-01-01 23:00", 30),
+	}, tl.Changes(MustParseDateTimeSpan("2018-01-01 12:00/2018-01-01 23:00")))
+	assert.Nil(t, tl.Changes(MustParseDateTimeSpan("../2018-01-01 06:00")))
+}
+
+func TestSpanTimelineCompactAndJSON(t *testing.T) {
+	tl := NewSpanTimeline[int](TimelineOverwrite)
+	tl.Insert(MustParseDateTimeSpan("2018-01-01 06:00/2018-01-01 14:00"), 10)
+	tl.Insert(MustParseDateTimeSpan("2018-01-01 14:00/2018-01-01 22:00"), 10)
+	tl.Insert(MustParseDateTimeSpan("2018-01-01 23:00/.."), 10)
+	tl.Compact()
+	assert.Equal(t, []SpanTimelineEntry[int]{
+		spanTimelineEntry("2018-01-01 06:00/2018-01-01 22:00", 10),
+		spanTimelineEntry("2018-01-01 23:00/..", 10),
+	}, tl.Entries())
+
+	data, err := json.Marshal(struct{ TL SpanTimeline[int] }{*tl})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"TL":[{"span":{"from":"2018-01-01 06:00","to":"2018-01-01 22:00"},"value":10},`+
+		`{"span":{"from":"2018-01-01 23:00","to":null},"value":10}]}`, string(data))
+
+	var parsed struct{ TL SpanTimeline[int] }
+	assert.NoError(t, json.Unmarshal(data, &parsed))
+	assert.Equal(t, tl.Entries(), parsed.TL.Entries())
+}
@@ -24,6 +24,14 @@ const (
 	Saturday
 )
 
+// NullableWeekday represents a Weekday that may be null.
+// It implements the sql.Scanner interface so it can be used
+// as a scan destination, similar to sql.NullString.
+type NullableWeekday struct {
+	Weekday Weekday
+	Valid   bool
+}
+
 var weekdays = []Weekday{
 	Monday,
 	Tuesday,
@@ -78,3 +86,115 @@ func ParseWeekday(weekdayString string) (Weekday, error) {
 func (w Weekday) Value() (driver.Value, error) {
 	return strings.ToUpper(w.String()), nil
 }
+
+// Scan implements the Scanner interface.
+func (w *Weekday) Scan(value interface{}) error {
+	text, err := scanText("Weekday", value)
+	if err != nil {
+		return err
+	}
+	parsedWeekday, err := ParseWeekday(text)
+	if err != nil {
+		return err
+	}
+	*w = parsedWeekday
+	return nil
+}
+
+// NewNullableWeekday creates NullableWeekday which is valid unless weekday is NotAWeekday
+func NewNullableWeekday(weekday Weekday) NullableWeekday {
+	return NullableWeekday{Weekday: weekday, Valid: weekday != NotAWeekday}
+}
+
+// NullableWeekdayFromPtr creates NullableWeekday which is valid unless weekday is nil
+func NullableWeekdayFromPtr(weekday *Weekday) NullableWeekday {
+	if weekday == nil {
+		return NullableWeekday{}
+	}
+	return NullableWeekday{Weekday: *weekday, Valid: true}
+}
+
+// ValueOr returns the weekday if it is valid or defaultWeekday otherwise
+func (w NullableWeekday) ValueOr(defaultWeekday Weekday) Weekday {
+	if !w.Valid {
+		return defaultWeekday
+	}
+	return w.Weekday
+}
+
+// Ptr returns pointer to the weekday if it is valid or nil otherwise
+func (w NullableWeekday) Ptr() *Weekday {
+	if !w.Valid {
+		return nil
+	}
+	weekday := w.Weekday
+	return &weekday
+}
+
+// Scan implements the Scanner interface.
+// NULL column makes the weekday not valid.
+func (w *NullableWeekday) Scan(value interface{}) error {
+	if value == nil {
+		*w = NullableWeekday{}
+		return nil
+	}
+	var weekday Weekday
+	if err := weekday.Scan(value); err != nil {
+		*w = NullableWeekday{}
+		return err
+	}
+	*w = NullableWeekday{Weekday: weekday, Valid: true}
+	return nil
+}
+
+// Value implements the sql driver Valuer interface.
+func (w NullableWeekday) Value() (driver.Value, error) {
+	if !w.Valid {
+		return nil, nil
+	}
+	return w.Weekday.Value()
+}
+
+// MarshalJSON marshals the weekday to JSON, null if it is not valid
+func (w NullableWeekday) MarshalJSON() ([]byte, error) {
+	if !w.Valid {
+		return []byte("null"), nil
+	}
+	return w.Weekday.MarshalJSON()
+}
+
+// UnmarshalJSON parses JSON into the weekday, null makes it not valid
+func (w *NullableWeekday) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*w = NullableWeekday{}
+		return nil
+	}
+	var weekday Weekday
+	if err := json.Unmarshal(data, &weekday); err != nil {
+		return err
+	}
+	*w = NullableWeekday{Weekday: weekday, Valid: true}
+	return nil
+}
+
+// MarshalText serializes the weekday to string, empty if it is not valid
+func (w NullableWeekday) MarshalText() ([]byte, error) {
+	if !w.Valid {
+		return []byte{}, nil
+	}
+	return w.Weekday.MarshalText()
+}
+
+// UnmarshalText parses string into the weekday, empty string makes it not valid
+func (w *NullableWeekday) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*w = NullableWeekday{}
+		return nil
+	}
+	var weekday Weekday
+	if err := weekday.UnmarshalText(text); err != nil {
+		return err
+	}
+	*w = NullableWeekday{Weekday: weekday, Valid: true}
+	return nil
+}
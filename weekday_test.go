@@ -1,6 +1,7 @@
 package time
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -51,3 +52,27 @@ func TestConversionToTimeWeekday(t *testing.T) {
 	assert.Equal(t, Monday, Weekday(time.Monday))
 	assert.Equal(t, time.Tuesday, time.Weekday(Tuesday))
 }
+
+func TestNullableWeekday(t *testing.T) {
+	var w NullableWeekday
+	assert.NoError(t, w.Scan([]byte("MONDAY")))
+	assert.Equal(t, NewNullableWeekday(Monday), w)
+	value, err := w.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "MONDAY", value)
+
+	assert.NoError(t, w.Scan(nil))
+	assert.False(t, w.Valid)
+	assert.Error(t, w.Scan("someday"))
+
+	JSON, err := json.Marshal(map[string]NullableWeekday{"a": NewNullableWeekday(Friday), "b": {}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":"friday","b":null}`, string(JSON))
+	var weekdays map[string]NullableWeekday
+	assert.NoError(t, json.Unmarshal([]byte(`{"a":"Friday","b":null}`), &weekdays))
+	assert.Equal(t, map[string]NullableWeekday{"a": NewNullableWeekday(Friday), "b": {}}, weekdays)
+
+	assert.False(t, NewNullableWeekday(NotAWeekday).Valid)
+	assert.Equal(t, Sunday, NullableWeekday{}.ValueOr(Sunday))
+	assert.Nil(t, NullableWeekday{}.Ptr())
+}
@@ -0,0 +1,278 @@
+package time
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ZonedDateTime represents a date and time in a time zone together with resolved offset from UTC,
+// so it always denotes a single instant.
+type ZonedDateTime struct {
+	dateTime LocalDateTime
+	location *time.Location
+	offset   int
+}
+
+// ZoneTransition describes a change of time zone offset, e.g. daylight saving time change,
+// around local date-time which falls into a gap or an overlap.
+// Offsets are in seconds east of UTC.
+type ZoneTransition struct {
+	OffsetBefore int
+	OffsetAfter  int
+}
+
+// IsGap reports whether transition skips local date-times, e.g. when clocks are set forward in spring
+func (zt ZoneTransition) IsGap() bool {
+	return zt.OffsetAfter > zt.OffsetBefore
+}
+
+// IsOverlap reports whether transition repeats local date-times, e.g. when clocks are set back in autumn
+func (zt ZoneTransition) IsOverlap() bool {
+	return zt.OffsetAfter < zt.OffsetBefore
+}
+
+// ZoneResolver chooses offset (in seconds east of UTC) for local date-time
+// which doesn't exist (gap) or exists twice (overlap) in a time zone.
+type ZoneResolver func(dateTime LocalDateTime, location *time.Location, transition ZoneTransition) (int, error)
+
+// ErrSkippedLocalDateTime is returned by ResolveStrict for local date-time in a gap
+var ErrSkippedLocalDateTime = errors.New("Local date-time doesn't exist in time zone")
+
+// ErrAmbiguousLocalDateTime is returned by ResolveStrict for local date-time in an overlap
+var ErrAmbiguousLocalDateTime = errors.New("Local date-time is ambiguous in time zone")
+
+// ResolveEarlier chooses earlier of two instants. In an overlap it is the instant before transition,
+// in a gap local date-time is shifted backward by the length of the gap.
+func ResolveEarlier(_ LocalDateTime, _ *time.Location, transition ZoneTransition) (int, error) {
+	if transition.OffsetBefore > transition.OffsetAfter {
+		return transition.OffsetBefore, nil
+	}
+	return transition.OffsetAfter, nil
+}
+
+// ResolveLater chooses later of two instants. In an overlap it is the instant after transition,
+// in a gap local date-time is shifted forward by the length of the gap.
+func ResolveLater(_ LocalDateTime, _ *time.Location, transition ZoneTransition) (int, error) {
+	if transition.OffsetBefore < transition.OffsetAfter {
+		return transition.OffsetBefore, nil
+	}
+	return transition.OffsetAfter, nil
+}
+
+// ResolveShiftForward shifts local date-time in a gap forward by the length of the gap
+// and chooses the earlier instant in an overlap.
+func ResolveShiftForward(_ LocalDateTime, _ *time.Location, transition ZoneTransition) (int, error) {
+	return transition.OffsetBefore, nil
+}
+
+// ResolveStrict rejects local date-time which falls into a gap or an overlap
+func ResolveStrict(_ LocalDateTime, _ *time.Location, transition ZoneTransition) (int, error) {
+	if transition.IsGap() {
+		return 0, ErrSkippedLocalDateTime
+	}
+	return 0, ErrAmbiguousLocalDateTime
+}
+
+// AtZone combines date-time with a time zone. Resolver is called only when
+// date-time falls into a gap or an overlap.
+func (ldt LocalDateTime) AtZone(location *time.Location, resolver ZoneResolver) (ZonedDateTime, error) {
+	offsets, transition := zoneOffsets(ldt, location)
+	if len(offsets) == 1 {
+		return ZonedDateTime{dateTime: ldt, location: location, offset: offsets[0]}, nil
+	}
+	offset, err := resolver(ldt, location, transition)
+	if err != nil {
+		return ZonedDateTime{}, err
+	}
+	if offset != transition.OffsetBefore && offset != transition.OffsetAfter {
+		return ZonedDateTime{}, fmt.Errorf("Offset %v is not valid for %v in %v", formatOffset(offset), ldt, location)
+	}
+	instant := ldt.t.Add(-time.Duration(offset) * time.Second)
+	return ToZonedDateTime(instant.In(location)), nil
+}
+
+// MustAtZone is like AtZone but panics on error
+func (ldt LocalDateTime) MustAtZone(location *time.Location, resolver ZoneResolver) ZonedDateTime {
+	zdt, err := ldt.AtZone(location, resolver)
+	if err != nil {
+		panic(err)
+	}
+	return zdt
+}
+
+// zoneOffsets returns valid offsets of date-time in location, one for regular date-time,
+// none for date-time in a gap and two for date-time in an overlap.
+// In the last two cases the transition is returned as well.
+func zoneOffsets(ldt LocalDateTime, location *time.Location) ([]int, ZoneTransition) {
+	wall := ldt.t.Unix()
+	probe := ldt.GoTime(location)
+	_, current := probe.Zone()
+	start, end := probe.ZoneBounds()
+
+	candidates := []int{current}
+	if !start.IsZero() {
+		_, before := start.Add(-time.Second).Zone()
+		candidates = append([]int{before}, candidates...)
+	}
+	if !end.IsZero() {
+		_, after := end.Zone()
+		candidates = append(candidates, after)
+	}
+
+	var offsets []int
+	for _, offset := range candidates {
+		instant := time.Unix(wall-int64(offset), int64(ldt.t.Nanosecond())).In(location)
+		if _, actual := instant.Zone(); actual == offset && NewLocalDateTime(instant) == ldt && !containsOffset(offsets, offset) {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	if len(offsets) == 2 {
+		return offsets, ZoneTransition{OffsetBefore: offsets[0], OffsetAfter: offsets[1]}
+	}
+	if len(offsets) == 0 {
+		if !start.IsZero() {
+			_, before := start.Add(-time.Second).Zone()
+			if wall >= start.Unix()+int64(before) && wall < start.Unix()+int64(current) {
+				return offsets, ZoneTransition{OffsetBefore: before, OffsetAfter: current}
+			}
+		}
+		if !end.IsZero() {
+			_, after := end.Zone()
+			return offsets, ZoneTransition{OffsetBefore: current, OffsetAfter: after}
+		}
+	}
+	return offsets, ZoneTransition{OffsetBefore: current, OffsetAfter: current}
+}
+
+func containsOffset(offsets []int, offset int) bool {
+	for _, o := range offsets {
+		if o == offset {
+			return true
+		}
+	}
+	return false
+}
+
+// ToZonedDateTime converts go's time.Time to ZonedDateTime keeping its location
+func ToZonedDateTime(t time.Time) ZonedDateTime {
+	_, offset := t.Zone()
+	return ZonedDateTime{dateTime: NewLocalDateTime(t), location: t.Location(), offset: offset}
+}
+
+// LocalDateTime returns date and time without time zone
+func (zdt ZonedDateTime) LocalDateTime() LocalDateTime {
+	return zdt.dateTime
+}
+
+// Date returns the date component
+func (zdt ZonedDateTime) Date() LocalDate {
+	return zdt.dateTime.Date()
+}
+
+// Time returns the time component
+func (zdt ZonedDateTime) Time() LocalTime {
+	return zdt.dateTime.Time()
+}
+
+// Location returns time zone
+func (zdt ZonedDateTime) Location() *time.Location {
+	if zdt.location == nil {
+		return time.UTC
+	}
+	return zdt.location
+}
+
+// Offset returns offset from UTC in seconds east of UTC
+func (zdt ZonedDateTime) Offset() int {
+	return zdt.offset
+}
+
+// GoTime converts ZonedDateTime to time.Time in the same location
+func (zdt ZonedDateTime) GoTime() time.Time {
+	return zdt.dateTime.t.Add(-time.Duration(zdt.offset) * time.Second).In(zdt.Location())
+}
+
+// WithZoneSameInstant returns date-time in given time zone denoting the same instant
+func (zdt ZonedDateTime) WithZoneSameInstant(location *time.Location) ZonedDateTime {
+	return ToZonedDateTime(zdt.GoTime().In(location))
+}
+
+// WithZoneSameLocal returns the same local date-time in given time zone
+func (zdt ZonedDateTime) WithZoneSameLocal(location *time.Location, resolver ZoneResolver) (ZonedDateTime, error) {
+	return zdt.dateTime.AtZone(location, resolver)
+}
+
+// Before reports whether zdt is before other instant
+func (zdt ZonedDateTime) Before(other ZonedDateTime) bool {
+	return zdt.GoTime().Before(other.GoTime())
+}
+
+// After reports whether zdt is after other instant
+func (zdt ZonedDateTime) After(other ZonedDateTime) bool {
+	return zdt.GoTime().After(other.GoTime())
+}
+
+// Equal reports whether zdt and other denote the same instant, regardless of time zones
+func (zdt ZonedDateTime) Equal(other ZonedDateTime) bool {
+	return zdt.GoTime().Equal(other.GoTime())
+}
+
+// String formats date-time in ISO 8601 extended with zone ID, e.g. "2018-03-25T03:30+02:00[Europe/Warsaw]".
+// Seconds and fraction of a second are rendered only when not zero.
+func (zdt ZonedDateTime) String() string {
+	return zdt.dateTime.FormatStyle(LocalDateTimeStyleISO) + formatOffset(zdt.offset) + "[" + zdt.Location().String() + "]"
+}
+
+// ParseZonedDateTime parses string in form of "2018-03-25T03:30+02:00[Europe/Warsaw]" into ZonedDateTime.
+// Offset must be valid for the date-time in the zone.
+func ParseZonedDateTime(value string) (ZonedDateTime, error) {
+	open := strings.IndexByte(value, '[')
+	if open < 0 || !strings.HasSuffix(value, "]") {
+		return ZonedDateTime{}, fmt.Errorf("Wrong ZonedDateTime format: %v", value)
+	}
+	location, err := time.LoadLocation(value[open+1 : len(value)-1])
+	if err != nil {
+		return ZonedDateTime{}, err
+	}
+	t, err := time.Parse("2006-01-02T15:04:05.999999999Z07:00", value[:open])
+	if err != nil {
+		t, err = time.Parse("2006-01-02T15:04Z07:00", value[:open])
+		if err != nil {
+			return ZonedDateTime{}, err
+		}
+	}
+	zdt := ToZonedDateTime(t.In(location))
+	_, offset := t.Zone()
+	if zdt.offset != offset {
+		return ZonedDateTime{}, fmt.Errorf("Offset %v is not valid in %v: %v", formatOffset(offset), location, value)
+	}
+	return zdt, nil
+}
+
+// MustParseZonedDateTime is like ParseZonedDateTime but panics on error
+func MustParseZonedDateTime(value string) ZonedDateTime {
+	zdt, err := ParseZonedDateTime(value)
+	if err != nil {
+		panic(err)
+	}
+	return zdt
+}
+
+// formatOffset formats offset in seconds east of UTC as "Z", "+02:00" or "-03:30:15"
+func formatOffset(offset int) string {
+	if offset == 0 {
+		return "Z"
+	}
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	if offset%60 != 0 {
+		return fmt.Sprintf("%c%02d:%02d:%02d", sign, offset/3600, offset/60%60, offset%60)
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)
+}
@@ -0,0 +1,113 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func warsaw(t *testing.T) *time.Location {
+	location, err := time.LoadLocation("Europe/Warsaw")
+	assert.NoError(t, err)
+	return location
+}
+
+func TestAtZoneRegular(t *testing.T) {
+	zdt, err := MustParseLocalDateTime("2018-01-02 15:04").AtZone(warsaw(t), ResolveStrict)
+	assert.NoError(t, err)
+	assert.Equal(t, 3600, zdt.Offset())
+	assert.Equal(t, "2018-01-02T15:04+01:00[Europe/Warsaw]", zdt.String())
+	assert.Equal(t, time.Date(2018, 1, 2, 14, 4, 0, 0, time.UTC).Unix(), zdt.GoTime().Unix())
+	assert.Equal(t, MustParseLocalDateTime("2018-01-02 15:04"), zdt.LocalDateTime())
+}
+
+func TestAtZoneGap(t *testing.T) {
+	// clocks are set forward from 02:00 CET to 03:00 CEST on 2018-03-25
+	inGap := MustParseLocalDateTime("2018-03-25 02:30")
+	var tests = []struct {
+		resolver ZoneResolver
+		want     string
+	}{
+		{ResolveEarlier, "2018-03-25T01:30+01:00[Europe/Warsaw]"},
+		{ResolveLater, "2018-03-25T03:30+02:00[Europe/Warsaw]"},
+		{ResolveShiftForward, "2018-03-25T03:30+02:00[Europe/Warsaw]"},
+	}
+	for _, test := range tests {
+		zdt, err := inGap.AtZone(warsaw(t), test.resolver)
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, zdt.String())
+	}
+
+	_, err := inGap.AtZone(warsaw(t), ResolveStrict)
+	assert.Equal(t, ErrSkippedLocalDateTime, err)
+
+	var transition ZoneTransition
+	_, _ = inGap.AtZone(warsaw(t), func(_ LocalDateTime, _ *time.Location, zt ZoneTransition) (int, error) {
+		transition = zt
+		return zt.OffsetAfter, nil
+	})
+	assert.Equal(t, ZoneTransition{OffsetBefore: 3600, OffsetAfter: 7200}, transition)
+	assert.True(t, transition.IsGap())
+}
+
+func TestAtZoneOverlap(t *testing.T) {
+	// clocks are set back from 03:00 CEST to 02:00 CET on 2018-10-28
+	inOverlap := MustParseLocalDateTime("2018-10-28 02:30")
+	var tests = []struct {
+		resolver ZoneResolver
+		want     string
+	}{
+		{ResolveEarlier, "2018-10-28T02:30+02:00[Europe/Warsaw]"},
+		{ResolveLater, "2018-10-28T02:30+01:00[Europe/Warsaw]"},
+		{ResolveShiftForward, "2018-10-28T02:30+02:00[Europe/Warsaw]"},
+	}
+	for _, test := range tests {
+		zdt, err := inOverlap.AtZone(warsaw(t), test.resolver)
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, zdt.String())
+	}
+
+	_, err := inOverlap.AtZone(warsaw(t), ResolveStrict)
+	assert.Equal(t, ErrAmbiguousLocalDateTime, err)
+
+	_, err = inOverlap.AtZone(warsaw(t), func(_ LocalDateTime, _ *time.Location, _ ZoneTransition) (int, error) {
+		return 0, nil
+	})
+	assert.Error(t, err)
+}
+
+func TestZonedDateTimeConversions(t *testing.T) {
+	goTime := time.Date(2018, 7, 2, 15, 4, 5, 6, warsaw(t))
+	zdt := ToZonedDateTime(goTime)
+	assert.True(t, goTime.Equal(zdt.GoTime()))
+	assert.Equal(t, warsaw(t), zdt.Location())
+	assert.Equal(t, "2018-07-02T15:04:05.000000006+02:00[Europe/Warsaw]", zdt.String())
+
+	utc := zdt.WithZoneSameInstant(time.UTC)
+	assert.Equal(t, "2018-07-02T13:04:05.000000006Z[UTC]", utc.String())
+	assert.True(t, utc.Equal(zdt))
+	assert.False(t, utc.Before(zdt) || utc.After(zdt))
+
+	sameLocal, err := zdt.WithZoneSameLocal(time.UTC, ResolveStrict)
+	assert.NoError(t, err)
+	assert.True(t, sameLocal.After(zdt))
+	assert.Equal(t, zdt.LocalDateTime(), sameLocal.LocalDateTime())
+}
+
+func TestParseZonedDateTime(t *testing.T) {
+	zdt, err := ParseZonedDateTime("2018-03-25T03:30+02:00[Europe/Warsaw]")
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseLocalDateTime("2018-03-25 02:30").MustAtZone(warsaw(t), ResolveShiftForward), zdt)
+
+	zdt, err = ParseZonedDateTime("2018-01-02T15:04:05.5+01:00[Europe/Warsaw]")
+	assert.NoError(t, err)
+	assert.Equal(t, "2018-01-02T15:04:05.5+01:00[Europe/Warsaw]", zdt.String())
+
+	_, err = ParseZonedDateTime("2018-01-02T15:04+02:00[Europe/Warsaw]")
+	assert.Error(t, err)
+	_, err = ParseZonedDateTime("2018-01-02T15:04+01:00")
+	assert.Error(t, err)
+	_, err = ParseZonedDateTime("2018-01-02T15:04+01:00[Nowhere/Atlantis]")
+	assert.Error(t, err)
+}